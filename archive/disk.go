@@ -0,0 +1,395 @@
+// Package archive provides read-only disks for the contents of ZIP and TAR archives
+// and a Writer that builds archives.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+var (
+	// ErrNotFound is returned when a file does not exist in an archive.
+	ErrNotFound = errors.New("file not found in archive")
+	// ErrUnsupportedFormat is returned by Open and OpenFile when a file is not a ZIP, TAR or
+	// gzip compressed TAR archive.
+	ErrUnsupportedFormat = errors.New("unsupported archive format")
+)
+
+// Format is an archive format.
+type Format int
+
+const (
+	// Zip is the ZIP format.
+	Zip = Format(iota + 1)
+	// Tar is the uncompressed TAR format.
+	Tar
+	// TarGzip is the gzip compressed TAR format.
+	TarGzip
+)
+
+func (f Format) String() string {
+	switch f {
+	case Zip:
+		return "zip"
+	case Tar:
+		return "tar"
+	case TarGzip:
+		return "tar.gz"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// FormatOf returns the format of an archive by the extension of its path
+// (.zip, .tar, .tar.gz or .tgz).
+func FormatOf(p string) (Format, bool) {
+	p = strings.ToLower(p)
+	switch {
+	case strings.HasSuffix(p, ".zip"):
+		return Zip, true
+	case strings.HasSuffix(p, ".tar"):
+		return Tar, true
+	case strings.HasSuffix(p, ".tar.gz"), strings.HasSuffix(p, ".tgz"):
+		return TarGzip, true
+	default:
+		return 0, false
+	}
+}
+
+// headerSize is the number of bytes that detectFormat needs: the size of a TAR header block.
+const headerSize = 512
+
+// detectFormat returns the format of an archive by its first bytes.
+func detectFormat(header []byte) (Format, error) {
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")), bytes.HasPrefix(header, []byte("PK\x05\x06")):
+		return Zip, nil
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		return TarGzip, nil
+	case len(header) >= 262 && bytes.Equal(header[257:262], []byte("ustar")):
+		return Tar, nil
+	// An empty TAR archive consists of zero blocks only.
+	case len(header) == headerSize && bytes.Count(header, []byte{0}) == headerSize:
+		return Tar, nil
+	default:
+		return 0, ErrUnsupportedFormat
+	}
+}
+
+// Disk is a read-only disk for the files of an archive.
+// Directories of the archive are not listed, only the files in them.
+type Disk struct {
+	// Format is the format of the archive.
+	Format Format
+
+	files  map[string]*file
+	paths  []string
+	closer io.Closer
+}
+
+type file struct {
+	info godrive.FileInfo
+	open func() (io.ReadCloser, error)
+}
+
+// Open reads the archive at the given path of disk and returns a Disk for its files.
+// The format is detected from the content of the archive. If it is not a ZIP, TAR or gzip
+// compressed TAR archive, Open returns ErrUnsupportedFormat.
+//
+// If disk supports streaming (godrive.CapStreaming), the archive is read from the reader of
+// GetReader. If that reader is a file with random access (io.ReaderAt and io.Seeker), ZIP archives
+// are read directly from it and the Disk must be closed. Otherwise, ZIP archives are loaded into
+// memory and their files are decompressed when they are read. The files of TAR archives are
+// decompressed when the archive is opened.
+func Open(ctx context.Context, disk godrive.Disk, p string) (*Disk, error) {
+	if streamer, ok := disk.(godrive.Streamer); ok && godrive.Capabilities(disk).Has(godrive.CapStreaming) {
+		r, err := streamer.GetReader(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+
+		if f, ok := r.(randomAccessFile); ok {
+			return openFile(f)
+		}
+
+		defer r.Close()
+		return openStream(r)
+	}
+
+	b, err := disk.Get(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	r := bytes.NewReader(b)
+	return open(r, r.Size(), nil)
+}
+
+// OpenFile opens the archive file at the given path and returns a Disk for its files.
+// The format is detected from the content of the archive. If it is not a ZIP, TAR or gzip
+// compressed TAR archive, OpenFile returns ErrUnsupportedFormat.
+// ZIP archives are read directly from the file; call Close to close it.
+func OpenFile(p string) (*Disk, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	return openFile(f)
+}
+
+type randomAccessFile interface {
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
+// openFile opens the archive in f. f is closed unless f contains a ZIP archive.
+func openFile(f randomAccessFile) (*Disk, error) {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	d, err := open(f, size, f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if d.Format != Zip {
+		// TAR archives were read completely.
+		f.Close()
+		d.closer = nil
+	}
+
+	return d, nil
+}
+
+func open(r io.ReaderAt, size int64, closer io.Closer) (*Disk, error) {
+	header := make([]byte, headerSize)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	format, err := detectFormat(header[:n])
+	if err != nil {
+		return nil, err
+	}
+
+	d := newDisk(format, closer)
+	if format == Zip {
+		err = d.readZip(r, size)
+	} else {
+		err = d.readTar(io.NewSectionReader(r, 0, size))
+	}
+
+	return d.index(err)
+}
+
+// openStream opens an archive that can only be read sequentially.
+func openStream(r io.Reader) (*Disk, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(headerSize)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	format, err := detectFormat(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == Zip {
+		// The central directory of ZIP archives is at the end.
+		b, err := io.ReadAll(br)
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+		r := bytes.NewReader(b)
+		return open(r, r.Size(), nil)
+	}
+
+	d := newDisk(format, nil)
+	return d.index(d.readTar(br))
+}
+
+func newDisk(format Format, closer io.Closer) *Disk {
+	return &Disk{
+		Format: format,
+		files:  make(map[string]*file),
+		closer: closer,
+	}
+}
+
+// index sorts the paths of the files after the archive has been read with the given error.
+func (d *Disk) index(err error) (*Disk, error) {
+	if err != nil {
+		return nil, fmt.Errorf("read %s archive: %w", d.Format, err)
+	}
+
+	for p := range d.files {
+		d.paths = append(d.paths, p)
+	}
+	sort.Strings(d.paths)
+
+	return d, nil
+}
+
+func (d *Disk) readZip(r io.ReaderAt, size int64) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		d.add(f.Name, f.Modified, int64(f.UncompressedSize64), f.Open)
+	}
+
+	return nil
+}
+
+func (d *Disk) readTar(r io.Reader) error {
+	if d.Format == TarGzip {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+
+		d.add(hdr.Name, hdr.ModTime, hdr.Size, func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		})
+	}
+}
+
+func (d *Disk) add(name string, modTime time.Time, size int64, open func() (io.ReadCloser, error)) {
+	p := cleanPath(name)
+	if p == "" {
+		return
+	}
+
+	d.files[p] = &file{
+		info: godrive.FileInfo{
+			Path:        p,
+			Size:        size,
+			ContentType: mime.TypeByExtension(path.Ext(p)),
+			ModTime:     modTime,
+		},
+		open: open,
+	}
+}
+
+// cleanPath returns the path of a file in an archive without leading "./" and "/".
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// Put returns godrive.ErrReadOnly.
+func (d *Disk) Put(context.Context, string, []byte) error {
+	return godrive.ErrReadOnly
+}
+
+// PutReader returns godrive.ErrReadOnly.
+func (d *Disk) PutReader(context.Context, string, io.Reader) error {
+	return godrive.ErrReadOnly
+}
+
+// Delete returns godrive.ErrReadOnly.
+func (d *Disk) Delete(context.Context, string) error {
+	return godrive.ErrReadOnly
+}
+
+// Get returns the content of the file at the given path.
+// If the archive does not contain the file, it returns ErrNotFound.
+func (d *Disk) Get(ctx context.Context, p string) ([]byte, error) {
+	r, err := d.GetReader(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// GetReader returns a reader for the file at the given path.
+// If the archive does not contain the file, it returns ErrNotFound.
+func (d *Disk) GetReader(_ context.Context, p string) (io.ReadCloser, error) {
+	f, ok := d.files[cleanPath(p)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return f.open()
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (d *Disk) List(_ context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	var paths []string
+	for i := sort.SearchStrings(d.paths, prefix); i < len(d.paths) && strings.HasPrefix(d.paths[i], prefix); i++ {
+		paths = append(paths, d.paths[i])
+	}
+
+	return paths, nil
+}
+
+// Stat returns information about the file at the given path.
+// The content type is derived from the file extension.
+// If the archive does not contain the file, it returns ErrNotFound.
+func (d *Disk) Stat(_ context.Context, p string) (godrive.FileInfo, error) {
+	f, ok := d.files[cleanPath(p)]
+	if !ok {
+		return godrive.FileInfo{}, ErrNotFound
+	}
+	return f.info, nil
+}
+
+// Close closes the archive file if the Disk was created by OpenFile.
+func (d *Disk) Close() error {
+	if d.closer != nil {
+		return d.closer.Close()
+	}
+	return nil
+}
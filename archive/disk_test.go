@@ -0,0 +1,352 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/archive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+type streamDisk struct {
+	*memdisk.Disk
+	streamed bool
+}
+
+func (d *streamDisk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	d.streamed = true
+	return d.Put(ctx, path, b)
+}
+
+func (d *streamDisk) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	b, err := d.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+var testFiles = map[string]string{
+	"dir/file1.txt":     "content1",
+	"dir/sub/file2.txt": "content2",
+	"dirty.txt":         "dirty",
+	"empty.txt":         "",
+}
+
+func testArchive(t *testing.T, disk *archive.Disk) {
+	ctx := context.Background()
+
+	for path, content := range testFiles {
+		b, err := disk.Get(ctx, path)
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(b))
+
+		r, err := disk.GetReader(ctx, path)
+		assert.Nil(t, err)
+		b, _ = io.ReadAll(r)
+		assert.Nil(t, r.Close())
+		assert.Equal(t, content, string(b))
+	}
+
+	_, err := disk.Get(ctx, "missing.txt")
+	assert.Equal(t, archive.ErrNotFound, err)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+	paths, err = disk.List(ctx, "dir")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt", "dirty.txt"}, paths)
+
+	paths, err = disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Len(t, paths, 4)
+
+	info, err := disk.Stat(ctx, "/dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.Equal(t, "text/plain; charset=utf-8", info.ContentType)
+	assert.WithinDuration(t, time.Now(), info.ModTime, time.Minute)
+
+	_, err = disk.Stat(ctx, "missing.txt")
+	assert.Equal(t, archive.ErrNotFound, err)
+
+	assert.Equal(t, godrive.ErrReadOnly, disk.Put(ctx, "file.txt", nil))
+	assert.Equal(t, godrive.ErrReadOnly, disk.Delete(ctx, "dir/file1.txt"))
+}
+
+func TestWriter(t *testing.T) {
+	for _, path := range []string{"files.zip", "files.tar", "files.tar.gz", "files.tgz"} {
+		t.Run(path, func(t *testing.T) {
+			target := memdisk.New()
+			ctx := context.Background()
+
+			w, err := archive.NewWriter(target, path)
+			assert.Nil(t, err)
+
+			for p, content := range testFiles {
+				assert.Nil(t, w.Put(ctx, p, []byte(content)))
+			}
+			assert.Nil(t, w.Put(ctx, "deleted.txt", []byte("deleted")))
+			assert.Nil(t, w.Delete(ctx, "deleted.txt"))
+
+			paths, err := w.List(ctx, "dir/")
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+			assert.Nil(t, w.Flush(ctx))
+
+			disk, err := archive.Open(ctx, target, path)
+			assert.Nil(t, err)
+			format, _ := archive.FormatOf(path)
+			assert.Equal(t, format, disk.Format)
+
+			testArchive(t, disk)
+		})
+	}
+}
+
+func TestWriter_stream(t *testing.T) {
+	target := &streamDisk{Disk: memdisk.New()}
+	ctx := context.Background()
+
+	w, err := archive.NewWriter(target, "files", archive.UseFormat(archive.TarGzip))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, w.Flush(ctx))
+	assert.True(t, target.streamed)
+
+	// Flush writes all files again.
+	assert.Nil(t, w.Put(ctx, "other.txt", []byte("other")))
+	assert.Nil(t, w.Flush(ctx))
+
+	disk, err := archive.Open(ctx, target, "files")
+	assert.Nil(t, err)
+	assert.Equal(t, archive.TarGzip, disk.Format)
+
+	paths, err := disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"file.txt", "other.txt"}, paths)
+}
+
+// noStreamDisk implements Streamer but reports that it doesn't support streaming.
+type noStreamDisk struct {
+	*streamDisk
+}
+
+func (noStreamDisk) Capabilities() godrive.Capability {
+	return 0
+}
+
+func TestWriter_decoratedTarget(t *testing.T) {
+	ctx := context.Background()
+
+	stream := &streamDisk{Disk: memdisk.New()}
+	for _, target := range []godrive.Disk{
+		godrive.Intercept(godrive.Interceptor{})(memdisk.New()),
+		noStreamDisk{stream},
+	} {
+		w, err := archive.NewWriter(target, "files.zip")
+		assert.Nil(t, err)
+		assert.Nil(t, w.Put(ctx, "file.txt", []byte("content")))
+		assert.Nil(t, w.Flush(ctx))
+
+		disk, err := archive.Open(ctx, target, "files.zip")
+		assert.Nil(t, err)
+		b, err := disk.Get(ctx, "file.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("content"), b)
+	}
+	assert.False(t, stream.streamed)
+
+	target := godrive.Intercept(godrive.Interceptor{})(stream)
+	w, err := archive.NewWriter(target, "files.zip")
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush(ctx))
+	assert.True(t, stream.streamed)
+}
+
+// shortDisk stops reading in PutReader before the end without returning an error.
+type shortDisk struct {
+	*memdisk.Disk
+}
+
+func (d shortDisk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	b := make([]byte, 10)
+	n, _ := r.Read(b)
+	return d.Put(ctx, path, b[:n])
+}
+
+func (d shortDisk) GetReader(context.Context, string) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestWriter_incompleteStream(t *testing.T) {
+	ctx := context.Background()
+
+	w, err := archive.NewWriter(shortDisk{memdisk.New()}, "files.zip")
+	assert.Nil(t, err)
+	assert.Nil(t, w.Put(ctx, "file.txt", bytes.Repeat([]byte("content"), 100)))
+	assert.ErrorIs(t, w.Flush(ctx), io.ErrClosedPipe)
+}
+
+func TestNewWriter_unknownFormat(t *testing.T) {
+	_, err := archive.NewWriter(memdisk.New(), "files.rar")
+	assert.Equal(t, archive.ErrUnknownFormat, err)
+}
+
+func TestOpenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	// Directory entries are skipped and leading "./" is removed.
+	_, err := zw.Create("./dir/")
+	assert.Nil(t, err)
+	for p, content := range testFiles {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: "./" + p, Method: zip.Deflate, Modified: time.Now()})
+		assert.Nil(t, err)
+		fw.Write([]byte(content))
+	}
+	assert.Nil(t, zw.Close())
+
+	path := filepath.Join(dir, "upload")
+	assert.Nil(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+	disk, err := archive.OpenFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, archive.Zip, disk.Format)
+	testArchive(t, disk)
+	assert.Nil(t, disk.Close())
+
+	buf.Reset()
+	tw := tar.NewWriter(&buf)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "dir/", Mode: 0o755}))
+	for p, content := range testFiles {
+		assert.Nil(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: p, Mode: 0o644, Size: int64(len(content)), ModTime: time.Now()}))
+		tw.Write([]byte(content))
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+	disk, err = archive.OpenFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, archive.Tar, disk.Format)
+	testArchive(t, disk)
+	assert.Nil(t, disk.Close())
+}
+
+// fileDisk returns files from GetReader that support random access.
+type fileDisk struct {
+	dir string
+}
+
+func (d fileDisk) Put(_ context.Context, path string, b []byte) error {
+	return os.WriteFile(filepath.Join(d.dir, path), b, 0o644)
+}
+
+func (d fileDisk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return d.Put(ctx, path, b)
+}
+
+func (d fileDisk) Get(context.Context, string) ([]byte, error) {
+	return nil, errors.New("archive must not be loaded into memory")
+}
+
+func (d fileDisk) GetReader(_ context.Context, path string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(d.dir, path))
+}
+
+func (d fileDisk) Delete(_ context.Context, path string) error {
+	return os.Remove(filepath.Join(d.dir, path))
+}
+
+func TestOpen_randomAccess(t *testing.T) {
+	source := fileDisk{dir: t.TempDir()}
+	ctx := context.Background()
+
+	for _, format := range []archive.Format{archive.Zip, archive.Tar, archive.TarGzip} {
+		w, err := archive.NewWriter(source, "files", archive.UseFormat(format))
+		assert.Nil(t, err)
+		for p, content := range testFiles {
+			assert.Nil(t, w.Put(ctx, p, []byte(content)))
+		}
+		assert.Nil(t, w.Flush(ctx))
+
+		disk, err := archive.Open(ctx, source, "files")
+		assert.Nil(t, err)
+		assert.Equal(t, format, disk.Format)
+		testArchive(t, disk)
+		assert.Nil(t, disk.Close())
+	}
+}
+
+func TestOpen_empty(t *testing.T) {
+	ctx := context.Background()
+
+	for _, source := range []godrive.Disk{memdisk.New(), &streamDisk{Disk: memdisk.New()}} {
+		for _, format := range []archive.Format{archive.Zip, archive.Tar, archive.TarGzip} {
+			w, err := archive.NewWriter(source, "files", archive.UseFormat(format))
+			assert.Nil(t, err)
+			assert.Nil(t, w.Flush(ctx))
+
+			disk, err := archive.Open(ctx, source, "files")
+			assert.Nil(t, err)
+			assert.Equal(t, format, disk.Format)
+			paths, err := disk.List(ctx, "")
+			assert.Nil(t, err)
+			assert.Empty(t, paths)
+		}
+	}
+}
+
+func TestOpen_unsupportedFormat(t *testing.T) {
+	ctx := context.Background()
+
+	for _, source := range []godrive.Disk{memdisk.New(), &streamDisk{Disk: memdisk.New()}} {
+		source.Put(ctx, "file.txt", []byte("not an archive"))
+		_, err := archive.Open(ctx, source, "file.txt")
+		assert.Equal(t, archive.ErrUnsupportedFormat, err)
+
+		source.Put(ctx, "empty.txt", nil)
+		_, err = archive.Open(ctx, source, "empty.txt")
+		assert.Equal(t, archive.ErrUnsupportedFormat, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.Nil(t, os.WriteFile(path, []byte("not an archive"), 0o644))
+	_, err := archive.OpenFile(path)
+	assert.Equal(t, archive.ErrUnsupportedFormat, err)
+}
+
+func TestOpen_invalid(t *testing.T) {
+	source := memdisk.New()
+	ctx := context.Background()
+
+	source.Put(ctx, "broken.zip", []byte("PK\x03\x04broken"))
+	_, err := archive.Open(ctx, source, "broken.zip")
+	assert.NotNil(t, err)
+
+	_, err = archive.Open(ctx, source, "missing.zip")
+	assert.NotNil(t, err)
+}
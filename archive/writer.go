@@ -0,0 +1,236 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+// ErrUnknownFormat is returned by NewWriter when the format of the archive is not set
+// and cannot be derived from its path.
+var ErrUnknownFormat = errors.New("unknown archive format")
+
+// Writer is a disk that builds an archive. Files that are written to the Writer are kept in memory
+// until Flush writes the archive to the target disk.
+type Writer struct {
+	Config Config
+
+	target godrive.Disk
+	path   string
+
+	mux   sync.Mutex
+	files map[string]writerFile
+}
+
+// Config is the Writer configuration.
+type Config struct {
+	// Format is the format of the archive.
+	Format Format
+}
+
+// Option is a Writer configuration option.
+type Option func(*Config)
+
+// UseFormat sets the format of the archive.
+func UseFormat(f Format) Option {
+	return func(cfg *Config) {
+		cfg.Format = f
+	}
+}
+
+type writerFile struct {
+	content []byte
+	modTime time.Time
+}
+
+// NewWriter returns a Writer that builds an archive and writes it to the given path of target.
+// Unless the UseFormat option is used, the format is derived from the extension of path.
+func NewWriter(target godrive.Disk, path string, options ...Option) (*Writer, error) {
+	var cfg Config
+	cfg.Format, _ = FormatOf(path)
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	if cfg.Format < Zip || cfg.Format > TarGzip {
+		return nil, ErrUnknownFormat
+	}
+
+	return &Writer{
+		Config: cfg,
+		target: target,
+		path:   path,
+		files:  make(map[string]writerFile),
+	}, nil
+}
+
+// Put adds the file to the archive. An existing file at the same path is replaced.
+func (w *Writer) Put(_ context.Context, p string, b []byte) error {
+	name := cleanPath(p)
+	if name == "" {
+		return fmt.Errorf("invalid path '%s'", p)
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.files[name] = writerFile{
+		content: bytes.Clone(b),
+		modTime: time.Now(),
+	}
+
+	return nil
+}
+
+// Get returns a file that has been added to the archive.
+// If the file has not been added, it returns ErrNotFound.
+func (w *Writer) Get(_ context.Context, p string) ([]byte, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	f, ok := w.files[cleanPath(p)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return bytes.Clone(f.content), nil
+}
+
+// Delete removes a file from the archive.
+func (w *Writer) Delete(_ context.Context, p string) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	delete(w.files, cleanPath(p))
+	return nil
+}
+
+// List returns the paths of all files in the archive whose path begins with prefix, sorted by path.
+func (w *Writer) List(_ context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	var paths []string
+	for p := range w.files {
+		if strings.HasPrefix(p, prefix) {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// Flush writes the archive with all files that have been added so far to the target disk.
+// The files are kept, so Flush can be called again after more files have been added.
+// If the target disk supports streaming (godrive.CapStreaming), the archive is streamed to it.
+func (w *Writer) Flush(ctx context.Context) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	paths := make([]string, 0, len(w.files))
+	for p := range w.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	if streamer, ok := w.target.(godrive.Streamer); ok && godrive.Capabilities(w.target).Has(godrive.CapStreaming) {
+		pr, pw := io.Pipe()
+		done := make(chan error, 1)
+		go func() {
+			err := w.write(pw, paths)
+			pw.CloseWithError(err)
+			done <- err
+		}()
+
+		err := streamer.PutReader(ctx, w.path, pr)
+		// Stop the writing goroutine if PutReader returned early and wait for it, because it reads the files.
+		pr.CloseWithError(err)
+		if werr := <-done; err == nil {
+			// The archive is incomplete if PutReader didn't read it to the end.
+			err = werr
+		}
+		if err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := w.write(&buf, paths); err != nil {
+		return fmt.Errorf("build archive: %w", err)
+	}
+
+	if err := w.target.Put(ctx, w.path, buf.Bytes()); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Writer) write(out io.Writer, paths []string) error {
+	if w.Config.Format == Zip {
+		return w.writeZip(out, paths)
+	}
+
+	if w.Config.Format == TarGzip {
+		gz := gzip.NewWriter(out)
+		if err := w.writeTar(gz, paths); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+
+	return w.writeTar(out, paths)
+}
+
+func (w *Writer) writeZip(out io.Writer, paths []string) error {
+	zw := zip.NewWriter(out)
+	for _, p := range paths {
+		f := w.files[p]
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     p,
+			Method:   zip.Deflate,
+			Modified: f.modTime,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.content); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func (w *Writer) writeTar(out io.Writer, paths []string) error {
+	tw := tar.NewWriter(out)
+	for _, p := range paths {
+		f := w.files[p]
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     p,
+			Mode:     0o644,
+			Size:     int64(len(f.content)),
+			ModTime:  f.modTime,
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
@@ -6,16 +6,25 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"regexp"
-
-	"gopkg.in/yaml.v3"
 )
 
 // AutoWireConfig contains the configuration for the disk autowire.
 type AutoWireConfig struct {
-	Disks           map[string]DiskCreatorConfig
-	Creators        map[string]DiskCreator
-	DefaultDiskName string
+	Disks              map[string]DiskCreatorConfig
+	Creators           map[string]DiskCreator
+	DefaultDiskName    string
+	Middleware         []MiddlewareCreatorConfig
+	MiddlewareCreators map[string]MiddlewareCreator
+	Schemas            map[string]ConfigSchema
+	Decoders           map[string]ConfigDecoder
+	SecretResolvers    map[string]SecretResolver
+	// Init determines when NewManager creates the disks (see LazyInit and ParallelInit).
+	Init InitMode
+
+	// includes are the files that were included by the loaded configuration files.
+	includes []string
+	// envPrefixes are the prefixes that were passed to LoadEnv.
+	envPrefixes []string
 }
 
 // DiskCreatorConfig is the configuration for the creation of a single storage disk.
@@ -37,14 +46,48 @@ func (fn DiskCreatorFunc) CreateDisk(ctx context.Context, cfg map[string]interfa
 	return fn(ctx, cfg)
 }
 
+// MiddlewareCreatorConfig is the configuration for the creation of a single middleware.
+type MiddlewareCreatorConfig struct {
+	Name string
+	// Disks contains the names of the disks the middleware applies to.
+	// If Disks is empty, the middleware applies to all disks.
+	Disks  []string
+	Config map[string]interface{}
+}
+
+// MiddlewareCreator creates disk middleware.
+type MiddlewareCreator interface {
+	CreateMiddleware(ctx context.Context, cfg map[string]interface{}) (Middleware, error)
+}
+
+// MiddlewareCreatorFunc creates disk middleware.
+type MiddlewareCreatorFunc func(context.Context, map[string]interface{}) (Middleware, error)
+
+// CreateMiddleware creates disk middleware.
+func (fn MiddlewareCreatorFunc) CreateMiddleware(ctx context.Context, cfg map[string]interface{}) (Middleware, error) {
+	return fn(ctx, cfg)
+}
+
 // AutoWireOption is an autowire option.
 type AutoWireOption func(*AutoWireConfig)
 
 // NewAutoWire returns a new autowire configuration.
 func NewAutoWire(options ...AutoWireOption) *AutoWireConfig {
 	cfg := AutoWireConfig{
-		Disks:    make(map[string]DiskCreatorConfig),
-		Creators: make(map[string]DiskCreator),
+		Disks:              make(map[string]DiskCreatorConfig),
+		Creators:           make(map[string]DiskCreator),
+		MiddlewareCreators: make(map[string]MiddlewareCreator),
+		Schemas:            make(map[string]ConfigSchema),
+		Decoders: map[string]ConfigDecoder{
+			".yml":  YAMLDecoder,
+			".yaml": YAMLDecoder,
+			".json": JSONDecoder,
+			".toml": TOMLDecoder,
+		},
+		SecretResolvers: map[string]SecretResolver{
+			"env":  EnvSecrets,
+			"file": FileSecrets,
+		},
 	}
 
 	for _, opt := range options {
@@ -71,34 +114,111 @@ func (cfg *AutoWireConfig) Configure(diskname, provider string, config map[strin
 	}
 }
 
+// RegisterMiddleware registers a middleware creator.
+func (cfg *AutoWireConfig) RegisterMiddleware(name string, creator MiddlewareCreator) {
+	cfg.MiddlewareCreators[name] = creator
+}
+
+// UseMiddleware adds a middleware to the configuration.
+// If no disk names are provided, the middleware applies to all disks.
+func (cfg *AutoWireConfig) UseMiddleware(name string, config map[string]interface{}, disknames ...string) {
+	if config == nil {
+		config = make(map[string]interface{})
+	}
+
+	cfg.Middleware = append(cfg.Middleware, MiddlewareCreatorConfig{
+		Name:   name,
+		Disks:  disknames,
+		Config: config,
+	})
+}
+
 // NewManager creates a new Manager with the initialized storage disks.
-func (cfg *AutoWireConfig) NewManager(ctx context.Context) (*Manager, error) {
-	m := New()
+// The configuration is validated before any disk is created (see Validate).
+// By default the disks are created one after another, use LazyInit or ParallelInit to change that.
+//
+// The default disk of the Manager is the disk named by DefaultDiskName. If DefaultDiskName is empty
+// and only a single disk is configured, that disk is the default disk. Otherwise the Manager has
+// no default disk and operations on the Manager itself return ErrNoDefaultDisk.
+// The options are passed to New.
+func (cfg *AutoWireConfig) NewManager(ctx context.Context, options ...ManagerOption) (*Manager, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
 
-	for diskname, diskcfg := range cfg.Disks {
-		creator, ok := cfg.Creators[diskcfg.Provider]
+	m := New(options...)
+
+	for _, mwcfg := range cfg.Middleware {
+		creator, ok := cfg.MiddlewareCreators[mwcfg.Name]
 		if !ok {
-			return nil, UnregisteredProviderError{Provider: diskcfg.Provider}
+			return nil, UnregisteredMiddlewareError{Name: mwcfg.Name}
 		}
 
-		disk, err := creator.CreateDisk(ctx, diskcfg.Config)
+		config, err := cfg.resolveSecrets(ctx, mwcfg.Config, ConfigSchema{})
 		if err != nil {
-			return nil, err
+			return nil, PlaceholderError{Middleware: mwcfg.Name, Err: err}
 		}
 
-		opts := []ConfigureOption{Replace()}
-		if cfg.DefaultDiskName == diskname {
-			opts = append(opts, Default())
+		mw, err := creator.CreateMiddleware(ctx, config)
+		if err != nil {
+			return nil, err
 		}
 
-		if err := m.Configure(diskname, disk, opts...); err != nil {
-			return nil, err
+		if len(mwcfg.Disks) == 0 {
+			m.Use(mw)
+			continue
 		}
+		m.UseFor(mwcfg.Disks, mw)
+	}
+
+	if err := cfg.initDisks(ctx, m); err != nil {
+		return nil, err
+	}
+
+	if err := m.SetDefault(cfg.defaultDiskName()); err != nil {
+		return nil, err
 	}
 
 	return m, nil
 }
 
+// defaultDiskName returns the name of the default disk: DefaultDiskName if set, or the name of the
+// only disk of the configuration. It returns an empty string if the default disk is ambiguous.
+func (cfg *AutoWireConfig) defaultDiskName() string {
+	if cfg.DefaultDiskName != "" {
+		return cfg.DefaultDiskName
+	}
+
+	if len(cfg.Disks) == 1 {
+		for diskname := range cfg.Disks {
+			return diskname
+		}
+	}
+
+	return ""
+}
+
+// resolveDiskConfig returns the configuration of a disk with defaults applied and secrets resolved.
+func (cfg *AutoWireConfig) resolveDiskConfig(ctx context.Context, diskname string, diskcfg DiskCreatorConfig) (map[string]interface{}, error) {
+	schema := cfg.Schemas[diskcfg.Provider]
+
+	config, err := cfg.resolveSecrets(ctx, schema.WithDefaults(diskcfg.Config), schema)
+	if err != nil {
+		return nil, PlaceholderError{DiskName: diskname, Err: err}
+	}
+
+	return config, nil
+}
+
+func (cfg *AutoWireConfig) createDisk(ctx context.Context, provider string, config map[string]interface{}) (Disk, error) {
+	creator, ok := cfg.Creators[provider]
+	if !ok {
+		return nil, UnregisteredProviderError{Provider: provider}
+	}
+
+	return creator.CreateDisk(ctx, config)
+}
+
 // UnregisteredProviderError means the configuration contains a disk with an unregistered provider.
 type UnregisteredProviderError struct {
 	Provider string
@@ -108,45 +228,134 @@ func (err UnregisteredProviderError) Error() string {
 	return fmt.Sprintf("unregistered storage provider '%s'", err.Provider)
 }
 
+// UnregisteredMiddlewareError means the configuration contains an unregistered middleware.
+type UnregisteredMiddlewareError struct {
+	Name string
+}
+
+func (err UnregisteredMiddlewareError) Error() string {
+	return fmt.Sprintf("unregistered middleware '%s'", err.Name)
+}
+
 // Load loads the disk configuration from a file.
-// It checks against provided file extensions and
-// returns an error if the filetype is unsupported.
-func (cfg *AutoWireConfig) Load(path string) error {
-	ext := filepath.Ext(path)
+// The decoder is chosen by the file extension (see RegisterDecoder) and
+// an error is returned if the filetype is unsupported.
+//
+// Files listed under the "include" key are resolved relative to the directory of the file.
+func (cfg *AutoWireConfig) Load(path string, options ...LoadOption) error {
+	dec, err := cfg.decoderFor(path)
+	if err != nil {
+		return err
+	}
+
+	return cfg.loadFile(path, dec, options)
+}
 
-	switch ext {
-	case ".yml":
-		fallthrough
-	case ".yaml":
-		return cfg.LoadYAML(path)
-	default:
-		return fmt.Errorf("unknown file extension for disk configuration '%s'", ext)
+// LoadReader loads the disk configuration from r using the given decoder.
+// Included files are resolved relative to the working directory.
+func (cfg *AutoWireConfig) LoadReader(r io.Reader, dec ConfigDecoder, options ...LoadOption) error {
+	raw, err := decodeRawConfig(r, dec)
+	if err != nil {
+		return err
 	}
+
+	return cfg.loadRaw(raw, ".", options)
 }
 
 // LoadYAML loads the disk configuration from a YAML file.
-func (cfg *AutoWireConfig) LoadYAML(path string) error {
-	f, err := os.Open(path)
+func (cfg *AutoWireConfig) LoadYAML(path string, options ...LoadOption) error {
+	return cfg.loadFile(path, YAMLDecoder, options)
+}
+
+// LoadYAMLReader loads the disk configuration from the YAML in r.
+func (cfg *AutoWireConfig) LoadYAMLReader(r io.Reader, options ...LoadOption) error {
+	return cfg.LoadReader(r, YAMLDecoder, options...)
+}
+
+// LoadJSON loads the disk configuration from a JSON file.
+func (cfg *AutoWireConfig) LoadJSON(path string, options ...LoadOption) error {
+	return cfg.loadFile(path, JSONDecoder, options)
+}
+
+// LoadJSONReader loads the disk configuration from the JSON in r.
+func (cfg *AutoWireConfig) LoadJSONReader(r io.Reader, options ...LoadOption) error {
+	return cfg.LoadReader(r, JSONDecoder, options...)
+}
+
+// LoadTOML loads the disk configuration from a TOML file.
+func (cfg *AutoWireConfig) LoadTOML(path string, options ...LoadOption) error {
+	return cfg.loadFile(path, TOMLDecoder, options)
+}
+
+// LoadTOMLReader loads the disk configuration from the TOML in r.
+func (cfg *AutoWireConfig) LoadTOMLReader(r io.Reader, options ...LoadOption) error {
+	return cfg.LoadReader(r, TOMLDecoder, options...)
+}
+
+func (cfg *AutoWireConfig) decoderFor(path string) (ConfigDecoder, error) {
+	ext := filepath.Ext(path)
+
+	dec, ok := cfg.Decoders[ext]
+	if !ok {
+		return nil, fmt.Errorf("unknown file extension for disk configuration '%s'", ext)
+	}
+
+	return dec, nil
+}
+
+func (cfg *AutoWireConfig) loadFile(path string, dec ConfigDecoder, options []LoadOption) error {
+	raw, err := readRawConfig(path, dec)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	return cfg.LoadYAMLReader(f)
+	return cfg.loadRaw(raw, filepath.Dir(path), options, path)
 }
 
-// LoadYAMLReader loads the disk configuration from the YAML in r.
-func (cfg *AutoWireConfig) LoadYAMLReader(r io.Reader) error {
-	var yamlcfg autowireYamlConfig
-	if err := yaml.NewDecoder(r).Decode(&yamlcfg); err != nil {
+func (cfg *AutoWireConfig) loadRaw(raw map[string]interface{}, dir string, options []LoadOption, seen ...string) error {
+	var opts loadConfig
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	raw, err := cfg.resolveIncludes(raw, dir, seen)
+	if err != nil {
 		return err
 	}
 
-	if err := yamlcfg.apply(cfg); err != nil {
+	if raw, err = selectProfile(raw, opts.profile); err != nil {
 		return err
 	}
 
-	return nil
+	rawcfg, err := decodeAutowireConfig(raw)
+	if err != nil {
+		return err
+	}
+
+	return rawcfg.apply(cfg)
+}
+
+func readRawConfig(path string, dec ConfigDecoder) (map[string]interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeRawConfig(f, dec)
+}
+
+func decodeRawConfig(r io.Reader, dec ConfigDecoder) (map[string]interface{}, error) {
+	raw, err := dec.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		raw = make(map[string]interface{})
+	}
+
+	return normalizeConfigValue(raw).(map[string]interface{}), nil
 }
 
 type autowireYamlConfig struct {
@@ -154,6 +363,14 @@ type autowireYamlConfig struct {
 	Disks map[string]map[string]interface{}
 	// Default is the name of the default disk.
 	Default string
+	// Middleware is the list of middleware to apply to the disks.
+	Middleware []autowireYamlMiddleware
+}
+
+type autowireYamlMiddleware struct {
+	Name   string
+	Disks  []string
+	Config map[string]interface{}
 }
 
 func (cfg autowireYamlConfig) apply(config *AutoWireConfig) error {
@@ -189,7 +406,9 @@ func (cfg autowireYamlConfig) apply(config *AutoWireConfig) error {
 			varcfg = tcfg
 		}
 
-		applyEnvVars(varcfg)
+		if err := applyEnvVars(varcfg, config.Schemas[provider]); err != nil {
+			return PlaceholderError{DiskName: diskname, Err: err}
+		}
 
 		disks[diskname] = DiskCreatorConfig{
 			Provider: provider,
@@ -203,6 +422,30 @@ func (cfg autowireYamlConfig) apply(config *AutoWireConfig) error {
 
 	config.DefaultDiskName = cfg.Default
 
+	for _, mwcfg := range cfg.Middleware {
+		if mwcfg.Name == "" {
+			return InvalidMiddlewareConfigError{Details: "middleware name must be set"}
+		}
+
+		for _, diskname := range mwcfg.Disks {
+			if _, ok := config.Disks[diskname]; !ok {
+				return InvalidMiddlewareConfigError{
+					Name:    mwcfg.Name,
+					Details: fmt.Sprintf("unconfigured disk '%s'", diskname),
+				}
+			}
+		}
+
+		if mwcfg.Config == nil {
+			mwcfg.Config = make(map[string]interface{})
+		}
+		if err := applyEnvVars(mwcfg.Config, ConfigSchema{}); err != nil {
+			return PlaceholderError{Middleware: mwcfg.Name, Err: err}
+		}
+
+		config.UseMiddleware(mwcfg.Name, mwcfg.Config, mwcfg.Disks...)
+	}
+
 	return nil
 }
 
@@ -215,6 +458,16 @@ func (err DuplicateDiskConfigError) Error() string {
 	return fmt.Sprintf("duplicate configuration for disk '%s'", err.DiskName)
 }
 
+// InvalidMiddlewareConfigError means the YAML configuration contains an invalid middleware configuration.
+type InvalidMiddlewareConfigError struct {
+	Name    string
+	Details string
+}
+
+func (err InvalidMiddlewareConfigError) Error() string {
+	return fmt.Sprintf("invalid configuration for middleware '%s': %s", err.Name, err.Details)
+}
+
 // InvalidConfigValueError means a configuration value for a disk has a wrong type.
 type InvalidConfigValueError struct {
 	DiskName  string
@@ -226,22 +479,3 @@ type InvalidConfigValueError struct {
 func (err InvalidConfigValueError) Error() string {
 	return fmt.Sprintf("invalid config value for disk '%s': '%s' must be a '%T' but is a '%T'", err.DiskName, err.ConfigKey, err.Expected, err.Provided)
 }
-
-func applyEnvVars(cfg map[string]interface{}) {
-	for key, val := range cfg {
-		switch v := val.(type) {
-		case map[string]interface{}:
-			applyEnvVars(v)
-		case string:
-			cfg[key] = replaceEnvPlaceholders(v)
-		}
-	}
-}
-
-var envPlaceholderExpr = regexp.MustCompile(`(?Ui)\${(.+)}`)
-
-func replaceEnvPlaceholders(val string) string {
-	return envPlaceholderExpr.ReplaceAllStringFunc(val, func(placeholder string) string {
-		return os.Getenv(envPlaceholderExpr.ReplaceAllString(placeholder, "$1"))
-	})
-}
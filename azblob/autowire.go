@@ -0,0 +1,142 @@
+package azblob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for Azure Blob Storage.
+	Provider = "azblob"
+)
+
+// Schema is the autowire configuration schema for Azure Blob Storage disks.
+var Schema = godrive.ConfigSchema{
+	Description: "Azure Blob Storage",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "container",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Name of the blob container.",
+		},
+		{
+			Key:         "connectionString",
+			Type:        godrive.TypeString,
+			Description: "Connection string of the storage account. Alternative to accountName and accountKey.",
+		},
+		{
+			Key:         "accountName",
+			Type:        godrive.TypeString,
+			Description: "Name of the storage account.",
+		},
+		{
+			Key:         "accountKey",
+			Type:        godrive.TypeString,
+			Description: "Shared key of the storage account.",
+		},
+		{
+			Key:         "serviceURL",
+			Type:        godrive.TypeString,
+			Description: "URL of the blob service. Defaults to https://<accountName>.blob.core.windows.net/.",
+		},
+	},
+}
+
+// Register registers Azure Blob Storage as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new Azure Blob Storage disk from an autowire configuration.
+// The disk authenticates either with a connection string or with the name and shared key of the storage account.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	containerName, ok := cfg["container"].(string)
+	if !ok || containerName == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "container",
+			Details: "container must be set",
+		}
+	}
+
+	if connStr, ok := cfg["connectionString"].(string); ok && connStr != "" {
+		client, err := container.NewClientFromConnectionString(connStr, containerName, nil)
+		if err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "connectionString",
+				Details: err.Error(),
+			}
+		}
+		return NewDisk(client), nil
+	}
+
+	accountName, ok := cfg["accountName"].(string)
+	if !ok || accountName == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "accountName",
+			Details: "either connectionString or accountName and accountKey must be set",
+		}
+	}
+
+	accountKey, ok := cfg["accountKey"].(string)
+	if !ok || accountKey == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "accountKey",
+			Details: "account key must be set",
+		}
+	}
+
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)
+	if rurl, ok := cfg["serviceURL"]; ok {
+		u, ok := rurl.(string)
+		if !ok || u == "" {
+			return nil, InvalidConfigValueError{
+				Key:     "serviceURL",
+				Details: fmt.Sprintf("service url must be a non-empty string but it is '%T'", rurl),
+			}
+		}
+		serviceURL = u
+	}
+
+	containerURL, err := url.JoinPath(serviceURL, containerName)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "serviceURL",
+			Details: err.Error(),
+		}
+	}
+
+	cred, err := container.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "accountKey",
+			Details: err.Error(),
+		}
+	}
+
+	client, err := container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDisk(client), nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
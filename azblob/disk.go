@@ -0,0 +1,211 @@
+// Package azblob provides the Azure Blob Storage disk implementation.
+package azblob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/bounoable/godrive"
+)
+
+// copyPollInterval is the interval in which the status of a pending copy operation is checked.
+const copyPollInterval = 200 * time.Millisecond
+
+// Disk is the Azure Blob Storage disk.
+// Files are stored as block blobs in a single container.
+type Disk struct {
+	Client *container.Client
+}
+
+// NewDisk creates a new Azure Blob Storage disk for the container of client.
+func NewDisk(client *container.Client) *Disk {
+	if client == nil {
+		panic("invalid azure blob storage client")
+	}
+
+	return &Disk{Client: client}
+}
+
+// Put writes b to the file at the given path.
+func (d *Disk) Put(ctx context.Context, path string, b []byte) error {
+	_, err := d.Client.NewBlockBlobClient(path).UploadBuffer(ctx, b, nil)
+	return err
+}
+
+// PutReader writes r to the file at the given path.
+func (d *Disk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	_, err := d.Client.NewBlockBlobClient(path).UploadStream(ctx, r, nil)
+	return err
+}
+
+// Get retrieves the file at the given path.
+func (d *Disk) Get(ctx context.Context, path string) ([]byte, error) {
+	r, err := d.GetReader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetReader returns a reader for the file at the given path.
+func (d *Disk) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := d.Client.NewBlobClient(path).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(ctx context.Context, path string) error {
+	_, err := d.Client.NewBlobClient(path).Delete(ctx, nil)
+	return err
+}
+
+// GetURL returns the URL of the blob at the given path.
+// The URL is only accessible without authentication if public access is enabled for the container.
+func (d *Disk) GetURL(_ context.Context, path string) (string, error) {
+	return strings.TrimSuffix(d.Client.URL(), "/") + "/" + (&url.URL{Path: path}).EscapedPath(), nil
+}
+
+// List returns the paths of all files whose path begins with prefix.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	pager := d.Client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+
+	var paths []string
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return paths, err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name != nil {
+				paths = append(paths, *item.Name)
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// Stat returns information about the file at the given path.
+func (d *Disk) Stat(ctx context.Context, path string) (godrive.FileInfo, error) {
+	props, err := d.Client.NewBlobClient(path).GetProperties(ctx, nil)
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+
+	info := godrive.FileInfo{
+		Path:     path,
+		Metadata: metadataFromAzure(props.Metadata),
+	}
+	if props.ContentLength != nil {
+		info.Size = *props.ContentLength
+	}
+	if props.ContentType != nil {
+		info.ContentType = *props.ContentType
+	}
+	if props.LastModified != nil {
+		info.ModTime = *props.LastModified
+	}
+
+	return info, nil
+}
+
+// GetSignedURL returns a read-only SAS URL for the file at the given path that expires after expiry.
+// The disk must be authenticated with a shared key (account key or connection string with an account key).
+func (d *Disk) GetSignedURL(_ context.Context, path string, expiry time.Duration) (string, error) {
+	return d.Client.NewBlobClient(path).GetSASURL(sas.BlobPermissions{Read: true}, time.Now().Add(expiry), nil)
+}
+
+// Copy copies the file at src to dst.
+// Azure copies blobs asynchronously, so Copy waits until the copy operation is finished.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	client := d.Client.NewBlobClient(dst)
+
+	resp, err := client.StartCopyFromURL(ctx, d.Client.NewBlobClient(src).URL(), nil)
+	if err != nil {
+		return err
+	}
+
+	status := resp.CopyStatus
+	for status != nil && *status == blob.CopyStatusTypePending {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(copyPollInterval):
+		}
+
+		props, err := client.GetProperties(ctx, nil)
+		if err != nil {
+			return err
+		}
+		status = props.CopyStatus
+	}
+
+	if status != nil && *status != blob.CopyStatusTypeSuccess {
+		return fmt.Errorf("copy '%s' to '%s': copy status is '%s'", src, dst, *status)
+	}
+
+	return nil
+}
+
+// GetMetadata returns the custom metadata of the file at the given path.
+// Azure metadata keys are case-insensitive and are returned in lowercase.
+func (d *Disk) GetMetadata(ctx context.Context, path string) (map[string]string, error) {
+	info, err := d.Stat(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return info.Metadata, nil
+}
+
+// SetMetadata replaces the custom metadata of the file at the given path.
+func (d *Disk) SetMetadata(ctx context.Context, path string, metadata map[string]string) error {
+	md := make(map[string]*string, len(metadata))
+	for key, val := range metadata {
+		val := val
+		md[key] = &val
+	}
+
+	_, err := d.Client.NewBlobClient(path).SetMetadata(ctx, md, nil)
+	return err
+}
+
+// CheckHealth checks if the container exists and is accessible with the credentials of the disk.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	_, err := d.Client.GetProperties(ctx, nil)
+	return err
+}
+
+func metadataFromAzure(md map[string]*string) map[string]string {
+	if len(md) == 0 {
+		return nil
+	}
+
+	out := make(map[string]string, len(md))
+	for key, val := range md {
+		if val != nil {
+			out[strings.ToLower(key)] = *val
+		}
+	}
+	return out
+}
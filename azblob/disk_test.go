@@ -0,0 +1,140 @@
+package azblob_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/azblob"
+	"github.com/stretchr/testify/assert"
+)
+
+// Start Azurite and set AZURITE_CONNECTION_STRING to run the tests, e.g.:
+//
+//	docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+//	export AZURITE_CONNECTION_STRING="DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;"
+func newTestDisk(t *testing.T) *azblob.Disk {
+	connStr := os.Getenv("AZURITE_CONNECTION_STRING")
+	if connStr == "" {
+		t.Skip("AZURITE_CONNECTION_STRING not set")
+	}
+
+	ctx := context.Background()
+	name := fmt.Sprintf("godrive-test-%d", time.Now().UnixNano())
+
+	disk, err := azblob.NewAutoWire(ctx, map[string]interface{}{
+		"connectionString": connStr,
+		"container":        name,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := disk.(*azblob.Disk).Client
+	if _, err := client.Create(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Delete(context.Background(), nil)
+	})
+
+	return disk.(*azblob.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	disk := newTestDisk(t)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/file2.txt", strings.NewReader("content2")))
+	assert.Nil(t, disk.Put(ctx, "other.txt", []byte("other")))
+
+	b, err := disk.Get(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	r, err := disk.GetReader(ctx, "dir/file2.txt")
+	assert.Nil(t, err)
+	b, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, []byte("content2"), b)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/file2.txt"}, paths)
+
+	info, err := disk.Stat(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.False(t, info.ModTime.IsZero())
+
+	assert.Nil(t, disk.Copy(ctx, "dir/file1.txt", "copy.txt"))
+	b, err = disk.Get(ctx, "copy.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	assert.Nil(t, disk.SetMetadata(ctx, "other.txt", map[string]string{"owner": "bob"}))
+	md, err := disk.GetMetadata(ctx, "other.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"owner": "bob"}, md)
+
+	assert.Nil(t, disk.Delete(ctx, "other.txt"))
+	_, err = disk.Get(ctx, "other.txt")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_GetSignedURL(t *testing.T) {
+	disk := newTestDisk(t)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+
+	url, err := disk.GetSignedURL(ctx, "file.txt", time.Minute)
+	assert.Nil(t, err)
+
+	resp, err := http.Get(url)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []byte("content"), b)
+}
+
+func TestCapabilities(t *testing.T) {
+	client, err := container.NewClientWithNoCredential("https://account.blob.core.windows.net/container", nil)
+	assert.Nil(t, err)
+
+	caps := godrive.Capabilities(azblob.NewDisk(client))
+	assert.True(t, caps.Has(godrive.CapURL|godrive.CapStreaming|godrive.CapListing|godrive.CapStat|
+		godrive.CapSignedURL|godrive.CapCopy|godrive.CapMetadata|godrive.CapHealth))
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := azblob.NewAutoWire(ctx, map[string]interface{}{"accountName": "account", "accountKey": "a2V5"})
+	assert.Equal(t, azblob.InvalidConfigValueError{Key: "container", Details: "container must be set"}, err)
+
+	_, err = azblob.NewAutoWire(ctx, map[string]interface{}{"container": "files"})
+	assert.ErrorAs(t, err, &azblob.InvalidConfigValueError{})
+
+	disk, err := azblob.NewAutoWire(ctx, map[string]interface{}{
+		"container":   "files",
+		"accountName": "account",
+		"accountKey":  "a2V5",
+	})
+	assert.Nil(t, err)
+
+	url, err := disk.(godrive.URLProvider).GetURL(ctx, "dir/file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://account.blob.core.windows.net/files/dir/file.txt", url)
+}
@@ -0,0 +1,97 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for bbolt.
+	Provider = "bolt"
+)
+
+// Schema is the autowire configuration schema for bbolt disks.
+var Schema = godrive.ConfigSchema{
+	Description: "Embedded bbolt database",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "path",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Path of the database file. It is created if it doesn't exist.",
+		},
+		{
+			Key:         "bucket",
+			Type:        godrive.TypeString,
+			Default:     DefaultBucket,
+			Description: "Name of the bucket that contains the files.",
+		},
+		{
+			Key:         "timeout",
+			Type:        godrive.TypeString,
+			Default:     DefaultTimeout.String(),
+			Description: "Time to wait for the lock of the database file, e.g. '5s'.",
+		},
+	},
+}
+
+// Register registers bbolt as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new bbolt disk from an autowire configuration.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	path, ok := cfg["path"].(string)
+	if !ok || path == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "path",
+			Details: "path must be set",
+		}
+	}
+
+	var options []Option
+
+	if rbucket, ok := cfg["bucket"]; ok {
+		bucket, ok := rbucket.(string)
+		if !ok || bucket == "" {
+			return nil, InvalidConfigValueError{
+				Key:     "bucket",
+				Details: fmt.Sprintf("bucket must be a non-empty string but it is '%v'", rbucket),
+			}
+		}
+		options = append(options, Bucket(bucket))
+	}
+
+	if rtimeout, ok := cfg["timeout"]; ok {
+		s, _ := rtimeout.(string)
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "timeout",
+				Details: fmt.Sprintf("timeout must be a duration but it is '%v'", rtimeout),
+			}
+		}
+		options = append(options, Timeout(timeout))
+	}
+
+	return NewDisk(path, options...)
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
@@ -0,0 +1,386 @@
+// Package bolt provides a disk that stores files in an embedded bbolt database (a single file on the local file system).
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// DefaultBucket is the default name of the bucket that contains the files.
+	DefaultBucket = "godrive"
+	// DefaultTimeout is the default time to wait for the lock of the database file.
+	DefaultTimeout = time.Second
+
+	// compactTxSize is the maximum size of a transaction during compaction.
+	compactTxSize = 64 << 20
+)
+
+var (
+	// ErrNotFound is returned when a file does not exist.
+	ErrNotFound = errors.New("file not found")
+
+	contentBucket = []byte("content")
+	metaBucket    = []byte("meta")
+)
+
+// Disk is the bbolt disk.
+// Every write is an atomic transaction that is synced to the file before it returns.
+type Disk struct {
+	Config Config
+
+	// mux prevents operations while the database is compacted.
+	mux sync.RWMutex
+	db  *bbolt.DB
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// Path is the path of the database file.
+	Path string
+	// Bucket is the name of the bucket that contains the files.
+	Bucket string
+	// Timeout is the time to wait for the lock of the database file,
+	// which is held by another process that uses the database.
+	Timeout time.Duration
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// Bucket sets the name of the bucket that contains the files.
+// Use different buckets to store multiple disks in the same database.
+func Bucket(name string) Option {
+	return func(cfg *Config) {
+		cfg.Bucket = name
+	}
+}
+
+// Timeout sets the time to wait for the lock of the database file.
+func Timeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Timeout = timeout
+	}
+}
+
+// meta is the metadata of a file.
+type meta struct {
+	Size        int64             `json:"size"`
+	ContentType string            `json:"contentType"`
+	ModTime     time.Time         `json:"modTime"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// NewDisk opens or creates the database at path and returns a disk that stores the files in it.
+// Missing parent directories of path are created.
+func NewDisk(path string, options ...Option) (*Disk, error) {
+	cfg := Config{
+		Path:    path,
+		Bucket:  DefaultBucket,
+		Timeout: DefaultTimeout,
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	d := &Disk{Config: cfg}
+	if err := d.open(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *Disk) open() error {
+	if err := os.MkdirAll(filepath.Dir(d.Config.Path), 0o755); err != nil {
+		return err
+	}
+
+	db, err := bbolt.Open(d.Config.Path, 0o600, &bbolt.Options{Timeout: d.Config.Timeout})
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(d.Config.Bucket))
+		if err != nil {
+			return err
+		}
+		if _, err := b.CreateBucketIfNotExists(contentBucket); err != nil {
+			return err
+		}
+		_, err = b.CreateBucketIfNotExists(metaBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return fmt.Errorf("create bucket: %w", err)
+	}
+
+	d.db = db
+
+	return nil
+}
+
+// Put writes b to the file at the given path.
+func (d *Disk) Put(_ context.Context, path string, b []byte) error {
+	return d.update(func(content, metas *bbolt.Bucket) error {
+		m := meta{
+			Size:        int64(len(b)),
+			ContentType: http.DetectContentType(b),
+			ModTime:     time.Now().UTC(),
+		}
+
+		// Custom metadata is kept when a file is overwritten.
+		if prev, err := getMeta(metas, path); err == nil {
+			m.Metadata = prev.Metadata
+		}
+
+		if err := content.Put([]byte(path), b); err != nil {
+			return err
+		}
+		return putMeta(metas, path, m)
+	})
+}
+
+// Get retrieves the file at the given path.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Get(_ context.Context, path string) ([]byte, error) {
+	var b []byte
+	err := d.view(func(content, _ *bbolt.Bucket) error {
+		v := content.Get([]byte(path))
+		if v == nil {
+			return ErrNotFound
+		}
+		// Values are only valid during the transaction.
+		b = bytes.Clone(v)
+		return nil
+	})
+	return b, err
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(_ context.Context, path string) error {
+	return d.update(func(content, metas *bbolt.Bucket) error {
+		if err := content.Delete([]byte(path)); err != nil {
+			return err
+		}
+		return metas.Delete([]byte(path))
+	})
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (d *Disk) List(_ context.Context, prefix string) ([]string, error) {
+	var paths []string
+	err := d.view(func(content, _ *bbolt.Bucket) error {
+		c := content.Cursor()
+		for k, _ := c.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, _ = c.Next() {
+			paths = append(paths, string(k))
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// Stat returns information about the file at the given path.
+// The content type is detected from the content when the file is written.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Stat(_ context.Context, path string) (godrive.FileInfo, error) {
+	var info godrive.FileInfo
+	err := d.view(func(_, metas *bbolt.Bucket) error {
+		m, err := getMeta(metas, path)
+		if err != nil {
+			return err
+		}
+
+		info = godrive.FileInfo{
+			Path:        path,
+			Size:        m.Size,
+			ContentType: m.ContentType,
+			ModTime:     m.ModTime,
+			Metadata:    m.Metadata,
+		}
+		return nil
+	})
+	return info, err
+}
+
+// Copy copies the file at src to dst, including its metadata.
+// If src does not exist, it returns ErrNotFound.
+func (d *Disk) Copy(_ context.Context, src, dst string) error {
+	return d.update(func(content, metas *bbolt.Bucket) error {
+		v := content.Get([]byte(src))
+		if v == nil {
+			return ErrNotFound
+		}
+
+		m, err := getMeta(metas, src)
+		if err != nil {
+			return err
+		}
+		m.ModTime = time.Now().UTC()
+
+		if err := content.Put([]byte(dst), bytes.Clone(v)); err != nil {
+			return err
+		}
+		return putMeta(metas, dst, m)
+	})
+}
+
+// GetMetadata returns the custom metadata of the file at the given path.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) GetMetadata(_ context.Context, path string) (map[string]string, error) {
+	var metadata map[string]string
+	err := d.view(func(_, metas *bbolt.Bucket) error {
+		m, err := getMeta(metas, path)
+		metadata = m.Metadata
+		return err
+	})
+	return metadata, err
+}
+
+// SetMetadata replaces the custom metadata of the file at the given path.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) SetMetadata(_ context.Context, path string, metadata map[string]string) error {
+	return d.update(func(_, metas *bbolt.Bucket) error {
+		m, err := getMeta(metas, path)
+		if err != nil {
+			return err
+		}
+		m.Metadata = metadata
+		return putMeta(metas, path, m)
+	})
+}
+
+// CheckHealth checks if the database is open and readable.
+func (d *Disk) CheckHealth(_ context.Context) error {
+	return d.view(func(_, _ *bbolt.Bucket) error { return nil })
+}
+
+// Compact rewrites the database into a new file without free pages and replaces the database
+// file with it. bbolt never shrinks the database file, so compaction reclaims the space of deleted
+// and overwritten files. Other operations of the disk wait until the compaction is finished.
+func (d *Disk) Compact(ctx context.Context) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if d.db == nil {
+		return bbolt.ErrDatabaseNotOpen
+	}
+
+	tmpPath := d.Config.Path + ".compact"
+	os.Remove(tmpPath)
+
+	tmp, err := bbolt.Open(tmpPath, 0o600, &bbolt.Options{Timeout: d.Config.Timeout})
+	if err != nil {
+		return fmt.Errorf("compact: %w", err)
+	}
+
+	if err := bbolt.Compact(tmp, d.db, compactTxSize); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("compact: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("compact: %w", err)
+	}
+
+	if err := d.db.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("compact: %w", err)
+	}
+	d.db = nil
+
+	if err := os.Rename(tmpPath, d.Config.Path); err != nil {
+		os.Remove(tmpPath)
+		// Reopen the uncompacted database.
+		if oerr := d.open(); oerr != nil {
+			return fmt.Errorf("compact: %w (reopen database: %v)", err, oerr)
+		}
+		return fmt.Errorf("compact: %w", err)
+	}
+
+	return d.open()
+}
+
+// Close closes the database.
+func (d *Disk) Close() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.db == nil {
+		return nil
+	}
+
+	err := d.db.Close()
+	d.db = nil
+
+	return err
+}
+
+func (d *Disk) update(fn func(content, metas *bbolt.Bucket) error) error {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	if d.db == nil {
+		return bbolt.ErrDatabaseNotOpen
+	}
+
+	return d.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(d.Config.Bucket))
+		return fn(b.Bucket(contentBucket), b.Bucket(metaBucket))
+	})
+}
+
+func (d *Disk) view(fn func(content, metas *bbolt.Bucket) error) error {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	if d.db == nil {
+		return bbolt.ErrDatabaseNotOpen
+	}
+
+	return d.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(d.Config.Bucket))
+		return fn(b.Bucket(contentBucket), b.Bucket(metaBucket))
+	})
+}
+
+func getMeta(metas *bbolt.Bucket, path string) (meta, error) {
+	v := metas.Get([]byte(path))
+	if v == nil {
+		return meta{}, ErrNotFound
+	}
+
+	var m meta
+	if err := json.Unmarshal(v, &m); err != nil {
+		return meta{}, fmt.Errorf("decode metadata of '%s': %w", path, err)
+	}
+
+	return m, nil
+}
+
+func putMeta(metas *bbolt.Bucket, path string, m meta) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return metas.Put([]byte(path), b)
+}
@@ -0,0 +1,204 @@
+package bolt_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/bolt"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/bbolt"
+)
+
+func newTestDisk(t *testing.T, config map[string]interface{}) *bolt.Disk {
+	cfg := map[string]interface{}{
+		"path": filepath.Join(t.TempDir(), "data", "files.db"),
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := bolt.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { disk.(*bolt.Disk).Close() })
+
+	return disk.(*bolt.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.Put(ctx, "dir/sub/file2.txt", []byte("content2")))
+	assert.Nil(t, disk.Put(ctx, "dirty.txt", []byte("dirty")))
+	assert.Nil(t, disk.Put(ctx, "empty.txt", nil))
+
+	b, err := disk.Get(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	b, err = disk.Get(ctx, "empty.txt")
+	assert.Nil(t, err)
+	assert.Empty(t, b)
+
+	_, err = disk.Get(ctx, "missing.txt")
+	assert.Equal(t, bolt.ErrNotFound, err)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+	paths, err = disk.List(ctx, "dir")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt", "dirty.txt"}, paths)
+
+	paths, err = disk.List(ctx, "missing/")
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+
+	info, err := disk.Stat(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.Equal(t, "text/plain; charset=utf-8", info.ContentType)
+	assert.WithinDuration(t, time.Now(), info.ModTime, time.Minute)
+
+	assert.Nil(t, disk.SetMetadata(ctx, "dir/file1.txt", map[string]string{"owner": "bob"}))
+	metadata, err := disk.GetMetadata(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"owner": "bob"}, metadata)
+	assert.Equal(t, bolt.ErrNotFound, disk.SetMetadata(ctx, "missing.txt", nil))
+
+	assert.Nil(t, disk.Copy(ctx, "dir/file1.txt", "copies/file1.txt"))
+	b, err = disk.Get(ctx, "copies/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+	info, err = disk.Stat(ctx, "copies/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"owner": "bob"}, info.Metadata)
+
+	assert.Nil(t, disk.Delete(ctx, "dir/file1.txt"))
+	_, err = disk.Get(ctx, "dir/file1.txt")
+	assert.Equal(t, bolt.ErrNotFound, err)
+	_, err = disk.Stat(ctx, "dir/file1.txt")
+	assert.Equal(t, bolt.ErrNotFound, err)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "files.db")
+	ctx := context.Background()
+
+	disk, err := bolt.NewDisk(path)
+	assert.Nil(t, err)
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, disk.Close())
+
+	disk, err = bolt.NewDisk(path)
+	assert.Nil(t, err)
+	defer disk.Close()
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+}
+
+func TestDisk_buckets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "files.db")
+	ctx := context.Background()
+
+	a, err := bolt.NewDisk(path, bolt.Bucket("a"))
+	assert.Nil(t, err)
+	assert.Nil(t, a.Put(ctx, "file.txt", []byte("a")))
+	assert.Nil(t, a.Close())
+
+	b, err := bolt.NewDisk(path, bolt.Bucket("b"))
+	assert.Nil(t, err)
+	defer b.Close()
+
+	_, err = b.Get(ctx, "file.txt")
+	assert.Equal(t, bolt.ErrNotFound, err)
+}
+
+func TestDisk_locked(t *testing.T) {
+	disk := newTestDisk(t, nil)
+
+	_, err := bolt.NewDisk(disk.Config.Path, bolt.Timeout(10*time.Millisecond))
+	assert.ErrorIs(t, err, bbolt.ErrTimeout)
+}
+
+func TestDisk_Compact(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	content := make([]byte, 64<<10)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, disk.Put(ctx, fmt.Sprintf("file%d", i), content))
+	}
+	for i := 1; i < 100; i++ {
+		assert.Nil(t, disk.Delete(ctx, fmt.Sprintf("file%d", i)))
+	}
+
+	before, err := os.Stat(disk.Config.Path)
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Operations wait for the compaction.
+		b, err := disk.Get(ctx, "file0")
+		assert.Nil(t, err)
+		assert.Len(t, b, len(content))
+	}()
+
+	assert.Nil(t, disk.Compact(ctx))
+	wg.Wait()
+
+	after, err := os.Stat(disk.Config.Path)
+	assert.Nil(t, err)
+	assert.Less(t, after.Size(), before.Size())
+
+	b, err := disk.Get(ctx, "file0")
+	assert.Nil(t, err)
+	assert.Len(t, b, len(content))
+
+	_, err = os.Stat(disk.Config.Path + ".compact")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDisk_closed(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	assert.Nil(t, disk.Close())
+
+	assert.Equal(t, bbolt.ErrDatabaseNotOpen, disk.Put(context.Background(), "file.txt", nil))
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := bolt.NewAutoWire(ctx, map[string]interface{}{})
+	assert.Equal(t, bolt.InvalidConfigValueError{
+		Key:     "path",
+		Details: "path must be set",
+	}, err)
+
+	_, err = bolt.NewAutoWire(ctx, map[string]interface{}{"path": "files.db", "timeout": "never"})
+	assert.Equal(t, bolt.InvalidConfigValueError{
+		Key:     "timeout",
+		Details: "timeout must be a duration but it is 'never'",
+	}, err)
+
+	disk := newTestDisk(t, map[string]interface{}{"bucket": "uploads"})
+	assert.Equal(t, "uploads", disk.Config.Bucket)
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapListing|godrive.CapStat|godrive.CapCopy|godrive.CapMetadata|godrive.CapHealth))
+}
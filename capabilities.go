@@ -0,0 +1,133 @@
+package godrive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Capability is a set of optional features of a Disk.
+type Capability uint
+
+const (
+	// CapURL means the Disk implements URLProvider.
+	CapURL Capability = 1 << iota
+	// CapStreaming means the Disk implements Streamer.
+	CapStreaming
+	// CapListing means the Disk implements Lister.
+	CapListing
+	// CapStat means the Disk implements Stater.
+	CapStat
+	// CapSignedURL means the Disk implements SignedURLProvider.
+	CapSignedURL
+	// CapCopy means the Disk implements Copier.
+	CapCopy
+	// CapMetadata means the Disk implements MetadataProvider.
+	CapMetadata
+	// CapHealth means the Disk implements HealthChecker.
+	CapHealth
+	// CapVersioning means the Disk implements Versioner.
+	CapVersioning
+
+	// capAll contains all capabilities.
+	capAll = CapVersioning<<1 - 1
+)
+
+var capabilityNames = []struct {
+	cap  Capability
+	name string
+}{
+	{CapURL, "url"},
+	{CapStreaming, "streaming"},
+	{CapListing, "listing"},
+	{CapStat, "stat"},
+	{CapSignedURL, "signed_url"},
+	{CapCopy, "copy"},
+	{CapMetadata, "metadata"},
+	{CapHealth, "health"},
+	{CapVersioning, "versioning"},
+}
+
+// Has determines if c contains all capabilities of other.
+func (c Capability) Has(other Capability) bool {
+	return c&other == other
+}
+
+// Names returns the names of the capabilities in c.
+func (c Capability) Names() []string {
+	var names []string
+	for _, cn := range capabilityNames {
+		if c.Has(cn.cap) {
+			names = append(names, cn.name)
+		}
+	}
+	return names
+}
+
+func (c Capability) String() string {
+	return strings.Join(c.Names(), "|")
+}
+
+// CapabilityReporter reports the capabilities of a Disk.
+// Disks implement CapabilityReporter if the methods they implement do not match their
+// capabilities, e.g. decorators whose capabilities depend on the Disk they wrap.
+type CapabilityReporter interface {
+	Capabilities() Capability
+}
+
+// Capabilities returns the optional features that disk supports.
+// If disk implements CapabilityReporter, its report is returned.
+// Otherwise the capabilities are determined by the optional interfaces disk implements.
+func Capabilities(disk Disk) Capability {
+	if reporter, ok := disk.(CapabilityReporter); ok {
+		return reporter.Capabilities()
+	}
+
+	var c Capability
+	if _, ok := disk.(URLProvider); ok {
+		c |= CapURL
+	}
+	if _, ok := disk.(Streamer); ok {
+		c |= CapStreaming
+	}
+	if _, ok := disk.(Lister); ok {
+		c |= CapListing
+	}
+	if _, ok := disk.(Stater); ok {
+		c |= CapStat
+	}
+	if _, ok := disk.(SignedURLProvider); ok {
+		c |= CapSignedURL
+	}
+	if _, ok := disk.(Copier); ok {
+		c |= CapCopy
+	}
+	if _, ok := disk.(MetadataProvider); ok {
+		c |= CapMetadata
+	}
+	if _, ok := disk.(HealthChecker); ok {
+		c |= CapHealth
+	}
+	if _, ok := disk.(Versioner); ok {
+		c |= CapVersioning
+	}
+
+	return c
+}
+
+// Require returns a MissingCapabilitiesError if disk does not support all of the required capabilities.
+func Require(disk Disk, required Capability) error {
+	if missing := required &^ Capabilities(disk); missing != 0 {
+		return MissingCapabilitiesError{Missing: missing}
+	}
+	return nil
+}
+
+// MissingCapabilitiesError means a Disk does not support required capabilities.
+type MissingCapabilitiesError struct {
+	DiskName string
+	Missing  Capability
+}
+
+func (err MissingCapabilitiesError) Error() string {
+	return fmt.Sprintf("disk '%s' does not support capabilities: %s", err.DiskName, err.Missing)
+}
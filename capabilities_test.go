@@ -0,0 +1,80 @@
+package godrive_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/gcs"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/bounoable/godrive/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	all := godrive.CapURL | godrive.CapStreaming | godrive.CapListing | godrive.CapStat |
+		godrive.CapSignedURL | godrive.CapCopy | godrive.CapMetadata | godrive.CapHealth | godrive.CapVersioning
+
+	tests := []struct {
+		name     string
+		disk     godrive.Disk
+		expected godrive.Capability
+	}{
+		{
+			name:     "base disk",
+			disk:     memdisk.New(),
+			expected: 0,
+		},
+		{
+			name:     "url provider",
+			disk:     urlDisk{memdisk.New()},
+			expected: godrive.CapURL,
+		},
+		{
+			name:     "decorated",
+			disk:     godrive.Wrap(urlDisk{memdisk.New()}, godrive.Intercept(godrive.Interceptor{}), godrive.Intercept(godrive.Interceptor{})),
+			expected: godrive.CapURL,
+		},
+		{
+			name:     "gcs",
+			disk:     &gcs.Disk{},
+			expected: all,
+		},
+		{
+			name:     "s3",
+			disk:     s3.NewDisk(nil, "REGION", "BUCKET"),
+			expected: all,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, godrive.Capabilities(test.disk))
+		})
+	}
+}
+
+func TestCapability_String(t *testing.T) {
+	assert.Equal(t, "url|listing|copy", (godrive.CapURL | godrive.CapListing | godrive.CapCopy).String())
+}
+
+func TestManager_Require(t *testing.T) {
+	m := godrive.New()
+	m.Configure("main", urlDisk{memdisk.New()})
+	m.Use(godrive.Intercept(godrive.Interceptor{}))
+
+	caps, err := m.Capabilities("main")
+	assert.Nil(t, err)
+	assert.Equal(t, godrive.CapURL, caps)
+
+	assert.Nil(t, m.Require("main", godrive.CapURL))
+
+	err = m.Require("main", godrive.CapURL|godrive.CapListing|godrive.CapStat)
+	var missing godrive.MissingCapabilitiesError
+	assert.True(t, errors.As(err, &missing))
+	assert.Equal(t, "main", missing.DiskName)
+	assert.Equal(t, godrive.CapListing|godrive.CapStat, missing.Missing)
+
+	_, err = m.Capabilities("videos")
+	assert.Equal(t, godrive.UnconfiguredDiskError{Name: "videos"}, err)
+}
@@ -0,0 +1,236 @@
+// Package cas provides a content-addressable disk that deduplicates files with identical content.
+package cas
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// DefaultBlobPrefix is the default prefix of the blobs on the underlying disk.
+	DefaultBlobPrefix = "blobs/"
+	// DefaultIndexPrefix is the default prefix of the index entries of the default DiskIndex.
+	DefaultIndexPrefix = "index/"
+)
+
+// ErrNotFound is returned when a file does not exist.
+var ErrNotFound = errors.New("file not found")
+
+// Disk is a content-addressable disk. The content of every file is stored as a blob under its
+// SHA-256 hash on the underlying disk, so files with identical content share a single blob.
+// An Index maps the paths of the files to the blobs.
+//
+// Deleting a file only removes it from the index. Call GC to delete the blobs that are no longer referenced.
+type Disk struct {
+	Config Config
+
+	disk godrive.Disk
+
+	// mux prevents writes while blobs are garbage-collected.
+	mux sync.RWMutex
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// BlobPrefix is the prefix of the blobs on the underlying disk.
+	BlobPrefix string
+	// Index maps the paths of the files to the blobs.
+	Index Index
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// BlobPrefix sets the prefix of the blobs on the underlying disk.
+func BlobPrefix(prefix string) Option {
+	return func(cfg *Config) {
+		cfg.BlobPrefix = prefix
+	}
+}
+
+// UseIndex sets the Index that maps paths to blobs.
+// By default, a DiskIndex stores the index under DefaultIndexPrefix on the underlying disk.
+func UseIndex(index Index) Option {
+	return func(cfg *Config) {
+		cfg.Index = index
+	}
+}
+
+// NewDisk returns a content-addressable disk that stores the blobs on disk.
+func NewDisk(disk godrive.Disk, options ...Option) *Disk {
+	cfg := Config{BlobPrefix: DefaultBlobPrefix}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	if cfg.Index == nil {
+		cfg.Index = NewDiskIndex(disk, DefaultIndexPrefix)
+	}
+
+	return &Disk{
+		Config: cfg,
+		disk:   disk,
+	}
+}
+
+// Hash returns the hex encoded SHA-256 hash of b.
+func Hash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// BlobPath returns the path of the blob with the given hash on the underlying disk.
+func (d *Disk) BlobPath(hash string) string {
+	return d.Config.BlobPrefix + hash[:2] + "/" + hash
+}
+
+// Put writes b to the file at the given path.
+// The blob is only uploaded if no other file references a blob with the same content.
+func (d *Disk) Put(ctx context.Context, path string, b []byte) error {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	hash := Hash(b)
+
+	refs, err := d.Config.Index.Refs(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if refs == 0 {
+		if err := d.disk.Put(ctx, d.BlobPath(hash), b); err != nil {
+			return fmt.Errorf("write blob: %w", err)
+		}
+	}
+
+	return d.Config.Index.Set(ctx, path, Entry{
+		Hash:        hash,
+		Size:        int64(len(b)),
+		ContentType: http.DetectContentType(b),
+		ModTime:     time.Now().UTC(),
+	})
+}
+
+// Get retrieves the file at the given path.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Get(ctx context.Context, path string) ([]byte, error) {
+	e, err := d.Config.Index.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := d.disk.Get(ctx, d.BlobPath(e.Hash))
+	if err != nil {
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+
+	return b, nil
+}
+
+// Delete removes the file at the given path from the index.
+// The blob is deleted by GC when no other file references it.
+func (d *Disk) Delete(ctx context.Context, path string) error {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	return d.Config.Index.Remove(ctx, path)
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	return d.Config.Index.List(ctx, prefix)
+}
+
+// Stat returns information about the file at the given path.
+// The metadata contains the hash of the content under the "sha256" key.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Stat(ctx context.Context, path string) (godrive.FileInfo, error) {
+	e, err := d.Config.Index.Get(ctx, path)
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+
+	return godrive.FileInfo{
+		Path:        path,
+		Size:        e.Size,
+		ContentType: e.ContentType,
+		ModTime:     e.ModTime,
+		Metadata:    map[string]string{"sha256": e.Hash},
+	}, nil
+}
+
+// Copy copies the file at src to dst. The copy references the same blob, so no content is copied.
+// If src does not exist, it returns ErrNotFound.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	e, err := d.Config.Index.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+	e.ModTime = time.Now().UTC()
+
+	return d.Config.Index.Set(ctx, dst, e)
+}
+
+// GC deletes all blobs that are not referenced by any file and returns their hashes.
+// The underlying disk must support listing (godrive.CapListing).
+//
+// Writes to the disk wait until GC is finished, so a blob cannot be deleted while a file
+// that references it is written. This does not protect against other processes that write
+// to the same disk and index.
+func (d *Disk) GC(ctx context.Context) ([]string, error) {
+	lister, ok := d.disk.(godrive.Lister)
+	if !ok || !godrive.Capabilities(d.disk).Has(godrive.CapListing) {
+		return nil, godrive.UnimplementedError{Interface: new(godrive.Lister)}
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	blobs, err := lister.List(ctx, d.Config.BlobPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("list blobs: %w", err)
+	}
+
+	var deleted []string
+	for _, blob := range blobs {
+		hash := path.Base(blob)
+		if !isHash(hash) || blob != d.BlobPath(hash) {
+			continue
+		}
+
+		refs, err := d.Config.Index.Refs(ctx, hash)
+		if err != nil {
+			return deleted, err
+		}
+		if refs > 0 {
+			continue
+		}
+
+		if err := d.disk.Delete(ctx, blob); err != nil {
+			return deleted, fmt.Errorf("delete blob %s: %w", hash, err)
+		}
+		deleted = append(deleted, hash)
+	}
+
+	return deleted, nil
+}
+
+func isHash(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	return strings.Trim(s, "0123456789abcdef") == ""
+}
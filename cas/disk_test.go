@@ -0,0 +1,205 @@
+package cas_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/cas"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisk(t *testing.T) {
+	underlying := memdisk.NewLister()
+	disk := cas.NewDisk(underlying)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "a/invoice.pdf", []byte("content")))
+	assert.Nil(t, disk.Put(ctx, "b/invoice.pdf", []byte("content")))
+	assert.Nil(t, disk.Put(ctx, "c/other.txt", []byte("other")))
+
+	for path, content := range map[string]string{
+		"a/invoice.pdf": "content",
+		"b/invoice.pdf": "content",
+		"c/other.txt":   "other",
+	} {
+		b, err := disk.Get(ctx, path)
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(b))
+	}
+
+	_, err := disk.Get(ctx, "missing.txt")
+	assert.Equal(t, cas.ErrNotFound, err)
+
+	// Identical content is stored once.
+	blobs, _ := underlying.List(ctx, cas.DefaultBlobPrefix)
+	assert.ElementsMatch(t, []string{disk.BlobPath(cas.Hash([]byte("content"))), disk.BlobPath(cas.Hash([]byte("other")))}, blobs)
+
+	refs, err := disk.Config.Index.Refs(ctx, cas.Hash([]byte("content")))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, refs)
+
+	paths, err := disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/invoice.pdf", "b/invoice.pdf", "c/other.txt"}, paths)
+
+	info, err := disk.Stat(ctx, "c/other.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "c/other.txt", info.Path)
+	assert.Equal(t, int64(len("other")), info.Size)
+	assert.Equal(t, "text/plain; charset=utf-8", info.ContentType)
+	assert.WithinDuration(t, time.Now(), info.ModTime, time.Minute)
+	assert.Equal(t, cas.Hash([]byte("other")), info.Metadata["sha256"])
+
+	puts := underlying.Puts()
+	assert.Nil(t, disk.Copy(ctx, "c/other.txt", "d/other.txt"))
+	// Only the index entry is written.
+	assert.Equal(t, puts+1, underlying.Puts())
+	b, err := disk.Get(ctx, "d/other.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("other"), b)
+
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapListing|godrive.CapStat|godrive.CapCopy))
+}
+
+func TestDisk_overwrite(t *testing.T) {
+	disk := cas.NewDisk(memdisk.NewLister(), cas.UseIndex(cas.NewMemoryIndex()))
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("old")))
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("new")))
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new"), b)
+
+	refs, _ := disk.Config.Index.Refs(ctx, cas.Hash([]byte("old")))
+	assert.Equal(t, 0, refs)
+	refs, _ = disk.Config.Index.Refs(ctx, cas.Hash([]byte("new")))
+	assert.Equal(t, 1, refs)
+}
+
+func TestDisk_GC(t *testing.T) {
+	underlying := memdisk.NewLister()
+	disk := cas.NewDisk(underlying)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "a.txt", []byte("shared")))
+	assert.Nil(t, disk.Put(ctx, "b.txt", []byte("shared")))
+	assert.Nil(t, disk.Put(ctx, "c.txt", []byte("single")))
+	assert.Nil(t, underlying.Put(ctx, cas.DefaultBlobPrefix+"README", []byte("not a blob")))
+
+	assert.Nil(t, disk.Delete(ctx, "a.txt"))
+	assert.Nil(t, disk.Delete(ctx, "c.txt"))
+	assert.Nil(t, disk.Delete(ctx, "missing.txt"))
+
+	_, err := disk.Get(ctx, "a.txt")
+	assert.Equal(t, cas.ErrNotFound, err)
+
+	deleted, err := disk.GC(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{cas.Hash([]byte("single"))}, deleted)
+
+	b, err := disk.Get(ctx, "b.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("shared"), b)
+
+	_, err = underlying.Get(ctx, cas.DefaultBlobPrefix+"README")
+	assert.Nil(t, err)
+
+	assert.Nil(t, disk.Delete(ctx, "b.txt"))
+	deleted, err = disk.GC(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{cas.Hash([]byte("shared"))}, deleted)
+
+	// Content that was garbage-collected is uploaded again.
+	assert.Nil(t, disk.Put(ctx, "a.txt", []byte("shared")))
+	b, err = disk.Get(ctx, "a.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("shared"), b)
+}
+
+func TestDisk_GC_concurrent(t *testing.T) {
+	disk := cas.NewDisk(memdisk.NewLister())
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			path := fmt.Sprintf("file%d.txt", i)
+			assert.Nil(t, disk.Put(ctx, path, []byte(path)))
+			assert.Nil(t, disk.Delete(ctx, path))
+			assert.Nil(t, disk.Put(ctx, path, []byte(path)))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := disk.GC(ctx)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 20; i++ {
+		path := fmt.Sprintf("file%d.txt", i)
+		b, err := disk.Get(ctx, path)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(path), b)
+	}
+}
+
+func TestDiskIndex_persistence(t *testing.T) {
+	underlying := memdisk.NewLister()
+	ctx := context.Background()
+
+	disk := cas.NewDisk(underlying)
+	assert.Nil(t, disk.Put(ctx, "a.txt", []byte("content")))
+	assert.Nil(t, disk.Put(ctx, "b.txt", []byte("content")))
+	assert.Nil(t, disk.Delete(ctx, "b.txt"))
+
+	disk = cas.NewDisk(underlying)
+	b, err := disk.Get(ctx, "a.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+
+	refs, err := disk.Config.Index.Refs(ctx, cas.Hash([]byte("content")))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, refs)
+
+	paths, err := disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.txt"}, paths)
+}
+
+func TestDiskIndex_notLister(t *testing.T) {
+	disk := cas.NewDisk(struct{ godrive.Disk }{memdisk.NewLister()})
+
+	err := disk.Put(context.Background(), "a.txt", []byte("content"))
+	assert.Equal(t, godrive.UnimplementedError{Interface: new(godrive.Lister)}, err)
+}
+
+// noListDisk implements godrive.Lister but reports that it doesn't support listing.
+type noListDisk struct {
+	memdisk.Lister
+}
+
+func (noListDisk) Capabilities() godrive.Capability {
+	return 0
+}
+
+func TestDisk_GC_notLister(t *testing.T) {
+	ctx := context.Background()
+
+	disk := cas.NewDisk(noListDisk{memdisk.NewLister()}, cas.UseIndex(cas.NewMemoryIndex()))
+	_, err := disk.GC(ctx)
+	assert.Equal(t, godrive.UnimplementedError{Interface: new(godrive.Lister)}, err)
+
+	disk = cas.NewDisk(noListDisk{memdisk.NewLister()})
+	err = disk.Put(ctx, "a.txt", []byte("content"))
+	assert.Equal(t, godrive.UnimplementedError{Interface: new(godrive.Lister)}, err)
+}
@@ -0,0 +1,250 @@
+package cas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+// Index maps the logical paths of files to the blobs that contain their content.
+// An Index counts the references to each blob, so unreferenced blobs can be garbage-collected.
+type Index interface {
+	// Get returns the entry of the file at the given path.
+	// If the path is not in the index, it returns ErrNotFound.
+	Get(ctx context.Context, path string) (Entry, error)
+	// Set maps the path to the entry. If the path was mapped to another entry, that entry is replaced.
+	Set(ctx context.Context, path string, e Entry) error
+	// Remove removes the path from the index. Removing a path that is not in the index is not an error.
+	Remove(ctx context.Context, path string) error
+	// List returns the paths of all files whose path begins with prefix, sorted by path.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// Refs returns the number of paths that reference the blob with the given hash.
+	Refs(ctx context.Context, hash string) (int, error)
+}
+
+// Entry is the index entry of a file.
+type Entry struct {
+	// Hash is the hex encoded SHA-256 hash of the content.
+	Hash        string    `json:"hash"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType"`
+	ModTime     time.Time `json:"modTime"`
+}
+
+// MemoryIndex is an Index that is kept in memory.
+type MemoryIndex struct {
+	mux     sync.RWMutex
+	entries map[string]Entry
+	refs    map[string]int
+}
+
+// NewMemoryIndex returns an empty MemoryIndex.
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		entries: make(map[string]Entry),
+		refs:    make(map[string]int),
+	}
+}
+
+// Get returns the entry of the file at the given path.
+func (idx *MemoryIndex) Get(_ context.Context, path string) (Entry, error) {
+	idx.mux.RLock()
+	defer idx.mux.RUnlock()
+
+	e, ok := idx.entries[path]
+	if !ok {
+		return Entry{}, ErrNotFound
+	}
+	return e, nil
+}
+
+// Set maps the path to the entry.
+func (idx *MemoryIndex) Set(_ context.Context, path string, e Entry) error {
+	idx.mux.Lock()
+	defer idx.mux.Unlock()
+	idx.set(path, e)
+	return nil
+}
+
+func (idx *MemoryIndex) set(path string, e Entry) {
+	idx.remove(path)
+	idx.entries[path] = e
+	idx.refs[e.Hash]++
+}
+
+// Remove removes the path from the index.
+func (idx *MemoryIndex) Remove(_ context.Context, path string) error {
+	idx.mux.Lock()
+	defer idx.mux.Unlock()
+	idx.remove(path)
+	return nil
+}
+
+func (idx *MemoryIndex) remove(path string) {
+	prev, ok := idx.entries[path]
+	if !ok {
+		return
+	}
+
+	delete(idx.entries, path)
+	if idx.refs[prev.Hash]--; idx.refs[prev.Hash] <= 0 {
+		delete(idx.refs, prev.Hash)
+	}
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (idx *MemoryIndex) List(_ context.Context, prefix string) ([]string, error) {
+	idx.mux.RLock()
+	defer idx.mux.RUnlock()
+
+	var paths []string
+	for p := range idx.entries {
+		if strings.HasPrefix(p, prefix) {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// Refs returns the number of paths that reference the blob with the given hash.
+func (idx *MemoryIndex) Refs(_ context.Context, hash string) (int, error) {
+	idx.mux.RLock()
+	defer idx.mux.RUnlock()
+	return idx.refs[hash], nil
+}
+
+// DiskIndex is an Index that stores every entry as a JSON file on a Disk.
+// The entries are loaded into memory on first use, which requires the Disk to support listing (godrive.CapListing).
+// The reference counts are derived from the entries, so they cannot get out of sync.
+//
+// A DiskIndex caches the index, so only one DiskIndex may write to the entries on a Disk at a time.
+// Use an Index that is backed by a database if multiple processes write to the same files.
+type DiskIndex struct {
+	disk   godrive.Disk
+	prefix string
+
+	mux    sync.Mutex
+	loaded bool
+	mem    *MemoryIndex
+}
+
+// NewDiskIndex returns a DiskIndex that stores the entries on disk under the given prefix.
+func NewDiskIndex(disk godrive.Disk, prefix string) *DiskIndex {
+	return &DiskIndex{
+		disk:   disk,
+		prefix: prefix,
+		mem:    NewMemoryIndex(),
+	}
+}
+
+func (idx *DiskIndex) load(ctx context.Context) error {
+	idx.mux.Lock()
+	defer idx.mux.Unlock()
+
+	if idx.loaded {
+		return nil
+	}
+
+	lister, ok := idx.disk.(godrive.Lister)
+	if !ok || !godrive.Capabilities(idx.disk).Has(godrive.CapListing) {
+		return godrive.UnimplementedError{Interface: new(godrive.Lister)}
+	}
+
+	files, err := lister.List(ctx, idx.prefix)
+	if err != nil {
+		return fmt.Errorf("load index: %w", err)
+	}
+
+	mem := NewMemoryIndex()
+	for _, file := range files {
+		b, err := idx.disk.Get(ctx, file)
+		if err != nil {
+			return fmt.Errorf("load index: %w", err)
+		}
+
+		var e Entry
+		if err := json.Unmarshal(b, &e); err != nil {
+			return fmt.Errorf("load index: decode entry '%s': %w", file, err)
+		}
+
+		mem.set(strings.TrimPrefix(file, idx.prefix), e)
+	}
+
+	idx.mem = mem
+	idx.loaded = true
+
+	return nil
+}
+
+// Get returns the entry of the file at the given path.
+func (idx *DiskIndex) Get(ctx context.Context, path string) (Entry, error) {
+	if err := idx.load(ctx); err != nil {
+		return Entry{}, err
+	}
+	return idx.mem.Get(ctx, path)
+}
+
+// Set maps the path to the entry and writes the entry to the disk.
+func (idx *DiskIndex) Set(ctx context.Context, path string, e Entry) error {
+	if err := idx.load(ctx); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	idx.mux.Lock()
+	defer idx.mux.Unlock()
+
+	if err := idx.disk.Put(ctx, idx.prefix+path, b); err != nil {
+		return fmt.Errorf("write index entry: %w", err)
+	}
+
+	return idx.mem.Set(ctx, path, e)
+}
+
+// Remove removes the path from the index and deletes its entry from the disk.
+func (idx *DiskIndex) Remove(ctx context.Context, path string) error {
+	if err := idx.load(ctx); err != nil {
+		return err
+	}
+
+	idx.mux.Lock()
+	defer idx.mux.Unlock()
+
+	if _, err := idx.mem.Get(ctx, path); err != nil {
+		return nil
+	}
+
+	if err := idx.disk.Delete(ctx, idx.prefix+path); err != nil {
+		return fmt.Errorf("delete index entry: %w", err)
+	}
+
+	return idx.mem.Remove(ctx, path)
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (idx *DiskIndex) List(ctx context.Context, prefix string) ([]string, error) {
+	if err := idx.load(ctx); err != nil {
+		return nil, err
+	}
+	return idx.mem.List(ctx, prefix)
+}
+
+// Refs returns the number of paths that reference the blob with the given hash.
+func (idx *DiskIndex) Refs(ctx context.Context, hash string) (int, error) {
+	if err := idx.load(ctx); err != nil {
+		return 0, err
+	}
+	return idx.mem.Refs(ctx, hash)
+}
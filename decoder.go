@@ -0,0 +1,188 @@
+package godrive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// YAMLDecoder decodes YAML configurations.
+	YAMLDecoder = ConfigDecoderFunc(func(r io.Reader) (map[string]interface{}, error) {
+		var raw map[string]interface{}
+		if err := yaml.NewDecoder(r).Decode(&raw); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	})
+
+	// JSONDecoder decodes JSON configurations.
+	JSONDecoder = ConfigDecoderFunc(func(r io.Reader) (map[string]interface{}, error) {
+		dec := json.NewDecoder(r)
+		dec.UseNumber()
+
+		var raw map[string]interface{}
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	})
+
+	// TOMLDecoder decodes TOML configurations.
+	TOMLDecoder = ConfigDecoderFunc(func(r io.Reader) (map[string]interface{}, error) {
+		var raw map[string]interface{}
+		if _, err := toml.NewDecoder(r).Decode(&raw); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	})
+)
+
+// ConfigDecoder decodes disk configurations.
+//
+// A decoder returns the generic structure of the configuration, which
+// must have the same shape as the YAML configuration:
+//
+//	default: main
+//	disks:
+//	  main:
+//	    provider: s3
+//	    config: {}
+type ConfigDecoder interface {
+	Decode(r io.Reader) (map[string]interface{}, error)
+}
+
+// ConfigDecoderFunc decodes disk configurations.
+type ConfigDecoderFunc func(io.Reader) (map[string]interface{}, error)
+
+// Decode decodes the configuration in r.
+func (fn ConfigDecoderFunc) Decode(r io.Reader) (map[string]interface{}, error) {
+	return fn(r)
+}
+
+// RegisterDecoder registers a configuration decoder for a file extension (e.g. ".hcl").
+// Load uses the decoder for files with the extension.
+func (cfg *AutoWireConfig) RegisterDecoder(ext string, dec ConfigDecoder) {
+	cfg.Decoders[ext] = dec
+}
+
+// normalizeConfigValue converts decoded values to the types that the YAML decoder produces,
+// so that providers receive the same types regardless of the configuration format.
+func normalizeConfigValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			out[key] = normalizeConfigValue(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeConfigValue(val)
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeConfigValue(val)
+		}
+		return out
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case int64:
+		return int(v)
+	default:
+		return v
+	}
+}
+
+func decodeAutowireConfig(raw map[string]interface{}) (autowireYamlConfig, error) {
+	var cfg autowireYamlConfig
+
+	if rdefault, ok := raw["default"]; ok {
+		def, ok := rdefault.(string)
+		if !ok {
+			return cfg, fmt.Errorf("invalid configuration: 'default' must be a string but is a '%T'", rdefault)
+		}
+		cfg.Default = def
+	}
+
+	if rdisks, ok := raw["disks"]; ok {
+		disks, ok := rdisks.(map[string]interface{})
+		if !ok {
+			return cfg, fmt.Errorf("invalid configuration: 'disks' must be a map but is a '%T'", rdisks)
+		}
+
+		cfg.Disks = make(map[string]map[string]interface{}, len(disks))
+		for diskname, rdisk := range disks {
+			disk, ok := rdisk.(map[string]interface{})
+			if !ok {
+				return cfg, fmt.Errorf("invalid configuration for disk '%s': must be a map but is a '%T'", diskname, rdisk)
+			}
+			cfg.Disks[diskname] = disk
+		}
+	}
+
+	if rmiddleware, ok := raw["middleware"]; ok {
+		middleware, ok := rmiddleware.([]interface{})
+		if !ok {
+			return cfg, fmt.Errorf("invalid configuration: 'middleware' must be a list but is a '%T'", rmiddleware)
+		}
+
+		for i, rmw := range middleware {
+			mw, err := decodeAutowireMiddleware(rmw)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid configuration for middleware #%d: %w", i, err)
+			}
+			cfg.Middleware = append(cfg.Middleware, mw)
+		}
+	}
+
+	return cfg, nil
+}
+
+func decodeAutowireMiddleware(raw interface{}) (autowireYamlMiddleware, error) {
+	var mw autowireYamlMiddleware
+
+	rmw, ok := raw.(map[string]interface{})
+	if !ok {
+		return mw, fmt.Errorf("must be a map but is a '%T'", raw)
+	}
+
+	if rname, ok := rmw["name"]; ok {
+		if mw.Name, ok = rname.(string); !ok {
+			return mw, fmt.Errorf("'name' must be a string but is a '%T'", rname)
+		}
+	}
+
+	if rdisks, ok := rmw["disks"]; ok {
+		disks, ok := rdisks.([]interface{})
+		if !ok {
+			return mw, fmt.Errorf("'disks' must be a list but is a '%T'", rdisks)
+		}
+
+		for _, rdisk := range disks {
+			disk, ok := rdisk.(string)
+			if !ok {
+				return mw, fmt.Errorf("'disks' must only contain strings but contains a '%T'", rdisk)
+			}
+			mw.Disks = append(mw.Disks, disk)
+		}
+	}
+
+	if rconfig, ok := rmw["config"]; ok {
+		if mw.Config, ok = rconfig.(map[string]interface{}); !ok {
+			return mw, fmt.Errorf("'config' must be a map but is a '%T'", rconfig)
+		}
+	}
+
+	return mw, nil
+}
@@ -0,0 +1,104 @@
+package godrive_test
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_formats(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "some-access-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "some-secret-access-key")
+
+	expected := godrive.NewAutoWire()
+	assert.Nil(t, expected.Load(filepath.Join("testdata", "autowire.yml")))
+
+	for _, file := range []string{"autowire.json", "autowire.toml"} {
+		t.Run(file, func(t *testing.T) {
+			cfg := godrive.NewAutoWire()
+			assert.Nil(t, cfg.Load(filepath.Join("testdata", file)))
+			assert.Equal(t, expected.Disks, cfg.Disks)
+			assert.Equal(t, expected.DefaultDiskName, cfg.DefaultDiskName)
+		})
+	}
+}
+
+func TestLoad_unknownExtension(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	err := cfg.Load("disks.ini")
+	assert.EqualError(t, err, "unknown file extension for disk configuration '.ini'")
+}
+
+func TestLoadReader_types(t *testing.T) {
+	tests := []struct {
+		name string
+		load func(*godrive.AutoWireConfig) error
+	}{
+		{
+			name: "json",
+			load: func(cfg *godrive.AutoWireConfig) error {
+				return cfg.LoadJSONReader(strings.NewReader(`{
+					"disks": {"main": {"provider": "test", "config": {"retries": 3, "ratio": 0.5}}},
+					"middleware": [{"name": "log", "disks": ["main"], "config": {"level": 4}}]
+				}`))
+			},
+		},
+		{
+			name: "toml",
+			load: func(cfg *godrive.AutoWireConfig) error {
+				return cfg.LoadTOMLReader(strings.NewReader(`
+[disks.main]
+provider = "test"
+config = { retries = 3, ratio = 0.5 }
+
+[[middleware]]
+name = "log"
+disks = ["main"]
+config = { level = 4 }
+`))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := godrive.NewAutoWire()
+			assert.Nil(t, test.load(cfg))
+
+			assert.Equal(t, map[string]interface{}{"retries": 3, "ratio": 0.5}, cfg.Disks["main"].Config)
+			assert.Equal(t, []godrive.MiddlewareCreatorConfig{{
+				Name:   "log",
+				Disks:  []string{"main"},
+				Config: map[string]interface{}{"level": 4},
+			}}, cfg.Middleware)
+		})
+	}
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	cfg.RegisterDecoder(".conf", godrive.ConfigDecoderFunc(func(r io.Reader) (map[string]interface{}, error) {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		disks := make(map[string]interface{})
+		for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			name, provider, _ := strings.Cut(line, "=")
+			disks[name] = map[string]interface{}{"provider": provider}
+		}
+
+		return map[string]interface{}{"disks": disks}, nil
+	}))
+
+	assert.Nil(t, cfg.Load(filepath.Join("testdata", "autowire.conf")))
+	assert.Equal(t, map[string]godrive.DiskCreatorConfig{
+		"main":   {Provider: "s3", Config: map[string]interface{}{}},
+		"videos": {Provider: "gcs", Config: map[string]interface{}{}},
+	}, cfg.Disks)
+}
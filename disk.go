@@ -1,6 +1,16 @@
 package godrive
 
-import "context"
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	// ErrReadOnly is returned by the write operations of read-only Disks.
+	ErrReadOnly = errors.New("disk is read-only")
+)
 
 // Disk provides the base cloud storage functions.
 type Disk interface {
@@ -17,3 +27,84 @@ type URLProvider interface {
 	// GetURL returns the public URL for the file at the given path.
 	GetURL(ctx context.Context, path string) (string, error)
 }
+
+// Streamer reads and writes files as streams.
+type Streamer interface {
+	// PutReader writes r to the file at the given path.
+	PutReader(ctx context.Context, path string, r io.Reader) error
+	// GetReader returns a reader for the file at the given path.
+	// The caller must close the reader.
+	GetReader(ctx context.Context, path string) (io.ReadCloser, error)
+}
+
+// Lister lists files.
+type Lister interface {
+	// List returns the paths of all files whose path begins with prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Stater provides information about files.
+type Stater interface {
+	// Stat returns information about the file at the given path.
+	Stat(ctx context.Context, path string) (FileInfo, error)
+}
+
+// FileInfo contains information about a file.
+type FileInfo struct {
+	Path        string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+	Metadata    map[string]string
+}
+
+// SignedURLProvider generates signed URLs for files.
+type SignedURLProvider interface {
+	// GetSignedURL returns a URL for the file at the given path that expires after expiry.
+	GetSignedURL(ctx context.Context, path string, expiry time.Duration) (string, error)
+}
+
+// Copier copies files.
+type Copier interface {
+	// Copy copies the file at src to dst.
+	Copy(ctx context.Context, src, dst string) error
+}
+
+// HealthChecker checks if the storage of a Disk is reachable.
+type HealthChecker interface {
+	// CheckHealth returns an error if the storage is not reachable or not usable.
+	CheckHealth(ctx context.Context) error
+}
+
+// MetadataProvider reads and writes custom metadata of files.
+type MetadataProvider interface {
+	// GetMetadata returns the custom metadata of the file at the given path.
+	GetMetadata(ctx context.Context, path string) (map[string]string, error)
+	// SetMetadata replaces the custom metadata of the file at the given path.
+	SetMetadata(ctx context.Context, path string, metadata map[string]string) error
+}
+
+// Versioner manages the versions of files.
+type Versioner interface {
+	// ListVersions returns the versions of the file at the given path, newest first.
+	ListVersions(ctx context.Context, path string) ([]Version, error)
+	// GetVersion retrieves a specific version of the file at the given path.
+	GetVersion(ctx context.Context, path, versionID string) ([]byte, error)
+	// RestoreVersion makes a copy of a specific version the current version of the file at the given path.
+	RestoreVersion(ctx context.Context, path, versionID string) error
+	// DeleteVersion permanently deletes a specific version of the file at the given path.
+	DeleteVersion(ctx context.Context, path, versionID string) error
+}
+
+// Version is a version of a file.
+type Version struct {
+	// ID identifies the version (the generation of a GCS object or the version ID of an S3 object).
+	ID      string
+	Size    int64
+	ModTime time.Time
+	// Latest reports whether the version is the current version of the file.
+	Latest bool
+	// DeleteMarker reports whether the version marks the deletion of the file.
+	// Delete markers have no content.
+	DeleteMarker bool
+}
@@ -0,0 +1,15 @@
+package godrive_test
+
+import (
+	"context"
+
+	"github.com/bounoable/godrive/internal/memdisk"
+)
+
+type urlDisk struct {
+	*memdisk.Disk
+}
+
+func (d urlDisk) GetURL(_ context.Context, path string) (string, error) {
+	return "https://example.test/" + path, nil
+}
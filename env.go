@@ -0,0 +1,203 @@
+package godrive
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LoadEnv loads the disk configuration from environment variables with the given prefix:
+//
+//	GODRIVE_DEFAULT=main
+//	GODRIVE_DISKS_MAIN_PROVIDER=s3
+//	GODRIVE_DISKS_MAIN_CONFIG_BUCKET=images
+//	GODRIVE_DISKS_MAIN_CONFIG_ACCESS_KEY_ID=...
+//	GODRIVE_DISKS_MAIN_CONFIG_PUBLIC=true
+//
+// Disk names are lowercased. Config keys are matched against the schema of the
+// provider (see RegisterSchema) and the existing configuration of the disk, ignoring
+// case and underscores; unknown keys are converted to lowerCamelCase (ACCESS_KEY_ID -> accessKeyId).
+//
+// Values are converted to the type of the schema field. Without a schema, booleans and
+// numbers are detected automatically.
+//
+// LoadEnv overlays the existing configuration, so it can be called after Load
+// to override single values of a configuration file.
+func (cfg *AutoWireConfig) LoadEnv(prefix string) error {
+	if !slices.Contains(cfg.envPrefixes, prefix) {
+		cfg.envPrefixes = append(cfg.envPrefixes, prefix)
+	}
+
+	prefix = strings.ToUpper(strings.TrimSuffix(prefix, "_")) + "_"
+	disksPrefix := prefix + "DISKS_"
+
+	providers := make(map[string]string)
+	configs := make(map[string]map[string]string)
+
+	for _, env := range os.Environ() {
+		key, val, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if key == prefix+"DEFAULT" {
+			cfg.DefaultDiskName = val
+			continue
+		}
+
+		if !strings.HasPrefix(key, disksPrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(key, disksPrefix)
+
+		if name, cfgkey, ok := strings.Cut(rest, "_CONFIG_"); ok && name != "" && cfgkey != "" {
+			name = strings.ToLower(name)
+			if configs[name] == nil {
+				configs[name] = make(map[string]string)
+			}
+			configs[name][cfgkey] = val
+			continue
+		}
+
+		if name := strings.TrimSuffix(rest, "_PROVIDER"); name != rest && name != "" {
+			providers[strings.ToLower(name)] = val
+		}
+	}
+
+	for name, provider := range providers {
+		diskcfg, ok := cfg.Disks[name]
+		if !ok {
+			diskcfg.Config = make(map[string]interface{})
+		}
+		diskcfg.Provider = provider
+		cfg.Disks[name] = diskcfg
+	}
+
+	for _, name := range sortedKeys(configs) {
+		diskcfg, ok := cfg.Disks[name]
+		if !ok {
+			return InvalidConfigValueError{
+				DiskName:  name,
+				ConfigKey: "provider",
+				Expected:  "",
+				Provided:  nil,
+			}
+		}
+
+		if diskcfg.Config == nil {
+			diskcfg.Config = make(map[string]interface{})
+		}
+
+		schema := cfg.Schemas[diskcfg.Provider]
+
+		for _, envkey := range sortedKeys(configs[name]) {
+			key := envConfigKey(envkey, schema, diskcfg.Config)
+
+			val, err := coerceEnvValue(configs[name][envkey], schema, key, diskcfg.Config[key])
+			if err != nil {
+				return ConfigKeyError{
+					DiskName: name,
+					Key:      key,
+					Details:  err.Error(),
+				}
+			}
+
+			diskcfg.Config[key] = val
+		}
+
+		cfg.Disks[name] = diskcfg
+	}
+
+	return nil
+}
+
+// envConfigKey returns the config key for the name of an environment variable.
+func envConfigKey(envkey string, schema ConfigSchema, existing map[string]interface{}) string {
+	normalized := normalizeEnvKey(envkey)
+
+	for _, field := range schema.Fields {
+		if normalizeEnvKey(field.Key) == normalized {
+			return field.Key
+		}
+	}
+
+	for key := range existing {
+		if normalizeEnvKey(key) == normalized {
+			return key
+		}
+	}
+
+	parts := strings.Split(strings.ToLower(envkey), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+func normalizeEnvKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", ""))
+}
+
+// coerceEnvValue converts the value of an environment variable to the type of the schema field.
+// If the schema has no field for the key, the type of the existing value is used.
+// Otherwise booleans and numbers are detected automatically.
+func coerceEnvValue(val string, schema ConfigSchema, key string, existing interface{}) (interface{}, error) {
+	typ := ConfigType("")
+	if field, ok := schema.Field(key); ok {
+		typ = field.Type
+	} else if existing != nil {
+		switch existing.(type) {
+		case string:
+			typ = TypeString
+		case bool:
+			typ = TypeBool
+		case int:
+			typ = TypeInt
+		case float64:
+			typ = TypeNumber
+		}
+	}
+
+	switch typ {
+	case TypeString:
+		return val, nil
+	case TypeBool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a boolean", val)
+		}
+		return b, nil
+	case TypeInt:
+		i, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not an integer", val)
+		}
+		return i, nil
+	case TypeNumber:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", val)
+		}
+		return f, nil
+	case "":
+		if val == "true" || val == "false" {
+			return val == "true", nil
+		}
+		if i, err := strconv.Atoi(val); err == nil {
+			return i, nil
+		}
+		// ParseFloat also accepts "inf" and "nan", which are more likely strings.
+		if f, err := strconv.ParseFloat(val, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return f, nil
+		}
+		return val, nil
+	default:
+		return val, nil
+	}
+}
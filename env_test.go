@@ -0,0 +1,81 @@
+package godrive_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/gcs"
+	"github.com/bounoable/godrive/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("GODRIVE_DEFAULT", "main")
+	t.Setenv("GODRIVE_DISKS_MAIN_PROVIDER", "s3")
+	t.Setenv("GODRIVE_DISKS_MAIN_CONFIG_REGION", "us-east-2")
+	t.Setenv("GODRIVE_DISKS_MAIN_CONFIG_BUCKET", "12345")
+	t.Setenv("GODRIVE_DISKS_MAIN_CONFIG_ACCESS_KEY_ID", "some-access-key-id")
+	t.Setenv("GODRIVE_DISKS_MAIN_CONFIG_PUBLIC", "1")
+	t.Setenv("GODRIVE_DISKS_MY_CACHE_PROVIDER", "redis")
+	t.Setenv("GODRIVE_DISKS_MY_CACHE_CONFIG_MAX_SIZE", "1024")
+	t.Setenv("GODRIVE_DISKS_MY_CACHE_CONFIG_COMPRESS", "false")
+	t.Setenv("GODRIVE_DISKS_MY_CACHE_CONFIG_HOST", "localhost")
+	t.Setenv("GODRIVE_DISKS_MY_CACHE_CONFIG_EVICTION", "nan")
+
+	cfg := godrive.NewAutoWire(s3.Register)
+	assert.Nil(t, cfg.LoadEnv("GODRIVE"))
+
+	assert.Equal(t, "main", cfg.DefaultDiskName)
+	assert.Equal(t, map[string]godrive.DiskCreatorConfig{
+		"main": {
+			Provider: "s3",
+			Config: map[string]interface{}{
+				"region":      "us-east-2",
+				"bucket":      "12345",
+				"accessKeyId": "some-access-key-id",
+				"public":      true,
+			},
+		},
+		"my_cache": {
+			Provider: "redis",
+			Config: map[string]interface{}{
+				"maxSize":  1024,
+				"compress": false,
+				"host":     "localhost",
+				"eviction": "nan",
+			},
+		},
+	}, cfg.Disks)
+}
+
+func TestLoadEnv_overlay(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "some-access-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "some-secret-access-key")
+	t.Setenv("APP_STORAGE_DEFAULT", "googlecloud")
+	t.Setenv("APP_STORAGE_DISKS_AMAZONAWS_CONFIG_BUCKET", "other-images")
+	t.Setenv("APP_STORAGE_DISKS_GOOGLECLOUD2_CONFIG_URL_TEMPLATE", "https://cdn.test/{{ .Path }}")
+	t.Setenv("APP_STORAGE_DISKS_OTHER_CONFIG_PUBLIC", "true")
+
+	cfg := godrive.NewAutoWire(gcs.Register, s3.Register)
+	assert.Nil(t, cfg.Load(filepath.Join("testdata", "autowire.yml")))
+	assert.Nil(t, cfg.LoadEnv("APP_STORAGE_"))
+
+	assert.Equal(t, "googlecloud", cfg.DefaultDiskName)
+	assert.Equal(t, "other-images", cfg.Disks["amazonaws"].Config["bucket"])
+	assert.Equal(t, "some-access-key-id", cfg.Disks["amazonaws"].Config["accessKeyId"])
+	assert.Equal(t, "https://cdn.test/{{ .Path }}", cfg.Disks["googlecloud2"].Config["urlTemplate"])
+	assert.Equal(t, true, cfg.Disks["other"].Config["public"])
+}
+
+func TestLoadEnv_invalid(t *testing.T) {
+	t.Setenv("GODRIVE_DISKS_MAIN_PROVIDER", "s3")
+	t.Setenv("GODRIVE_DISKS_MAIN_CONFIG_PUBLIC", "maybe")
+
+	cfg := godrive.NewAutoWire(s3.Register)
+	assert.Equal(t, godrive.ConfigKeyError{
+		DiskName: "main",
+		Key:      "public",
+		Details:  "'maybe' is not a boolean",
+	}, cfg.LoadEnv("GODRIVE"))
+}
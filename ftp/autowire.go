@@ -0,0 +1,196 @@
+package ftp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for FTP.
+	Provider = "ftp"
+)
+
+// Schema is the autowire configuration schema for FTP disks.
+var Schema = godrive.ConfigSchema{
+	Description: "FTP and FTPS",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "host",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Host name of the FTP server.",
+		},
+		{
+			Key:         "port",
+			Type:        godrive.TypeInt,
+			Description: "Port of the FTP server. Defaults to 990 for implicit TLS and to 21 otherwise.",
+		},
+		{
+			Key:         "user",
+			Type:        godrive.TypeString,
+			Default:     "anonymous",
+			Description: "FTP user.",
+		},
+		{
+			Key:         "password",
+			Type:        godrive.TypeString,
+			Description: "Password of the user.",
+		},
+		{
+			Key:         "tls",
+			Type:        godrive.TypeString,
+			Default:     "none",
+			Description: "TLS mode: 'none', 'explicit' (AUTH TLS) or 'implicit'.",
+		},
+		{
+			Key:         "insecureSkipVerify",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Don't verify the TLS certificate of the server. Use only for testing.",
+		},
+		{
+			Key:         "root",
+			Type:        godrive.TypeString,
+			Description: "Directory on the server that contains the files of the disk.",
+		},
+		{
+			Key:         "poolSize",
+			Type:        godrive.TypeInt,
+			Default:     DefaultPoolSize,
+			Description: "Maximum number of open connections.",
+		},
+		{
+			Key:         "idleTimeout",
+			Type:        godrive.TypeString,
+			Default:     DefaultIdleTimeout.String(),
+			Description: "Time after which idle connections are closed, e.g. '30s'. '0' keeps them open.",
+		},
+		{
+			Key:         "disableEPSV",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Use PASV instead of EPSV for passive data connections.",
+		},
+	},
+}
+
+// Register registers FTP as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new FTP disk from an autowire configuration.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	host, ok := cfg["host"].(string)
+	if !ok || host == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "host",
+			Details: "host must be set",
+		}
+	}
+
+	var options []Option
+
+	mode := TLSNone
+	if rmode, ok := cfg["tls"]; ok {
+		switch rmode {
+		case "none":
+		case "explicit":
+			mode = TLSExplicit
+		case "implicit":
+			mode = TLSImplicit
+		default:
+			return nil, InvalidConfigValueError{
+				Key:     "tls",
+				Details: fmt.Sprintf("tls must be 'none', 'explicit' or 'implicit' but it is '%v'", rmode),
+			}
+		}
+	}
+
+	if mode != TLSNone {
+		insecure, _ := cfg["insecureSkipVerify"].(bool)
+		options = append(options, TLS(mode, &tls.Config{
+			ServerName:         host,
+			InsecureSkipVerify: insecure,
+		}))
+	}
+
+	port := 21
+	if mode == TLSImplicit {
+		port = 990
+	}
+	if rport, ok := cfg["port"]; ok {
+		if port, ok = rport.(int); !ok {
+			return nil, InvalidConfigValueError{
+				Key:     "port",
+				Details: fmt.Sprintf("port must be an integer but it is '%T'", rport),
+			}
+		}
+	}
+
+	if user, ok := cfg["user"].(string); ok && user != "" {
+		password, _ := cfg["password"].(string)
+		options = append(options, Credentials(user, password))
+	}
+
+	if rroot, ok := cfg["root"]; ok {
+		root, ok := rroot.(string)
+		if !ok {
+			return nil, InvalidConfigValueError{
+				Key:     "root",
+				Details: fmt.Sprintf("root must be a string but it is '%T'", rroot),
+			}
+		}
+		options = append(options, Root(root))
+	}
+
+	if rsize, ok := cfg["poolSize"]; ok {
+		size, ok := rsize.(int)
+		if !ok || size < 1 {
+			return nil, InvalidConfigValueError{
+				Key:     "poolSize",
+				Details: fmt.Sprintf("pool size must be a positive integer but it is '%v'", rsize),
+			}
+		}
+		options = append(options, PoolSize(size))
+	}
+
+	if rtimeout, ok := cfg["idleTimeout"]; ok {
+		s, _ := rtimeout.(string)
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "idleTimeout",
+				Details: fmt.Sprintf("idle timeout must be a duration but it is '%v'", rtimeout),
+			}
+		}
+		options = append(options, IdleTimeout(timeout))
+	}
+
+	if disable, _ := cfg["disableEPSV"].(bool); disable {
+		options = append(options, DisableEPSV())
+	}
+
+	return NewDisk(net.JoinHostPort(host, strconv.Itoa(port)), options...), nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
@@ -0,0 +1,442 @@
+// Package ftp provides the FTP and FTPS disk implementation.
+package ftp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/jlaffaye/ftp"
+)
+
+const (
+	// DefaultPoolSize is the default maximum number of open connections of a disk.
+	DefaultPoolSize = 4
+	// DefaultIdleTimeout is the default time after which idle connections are closed.
+	DefaultIdleTimeout = time.Minute
+)
+
+// TLSMode determines if and how connections are encrypted.
+type TLSMode int
+
+const (
+	// TLSNone uses unencrypted connections (default).
+	TLSNone = TLSMode(iota)
+	// TLSExplicit connects unencrypted and upgrades the connection with AUTH TLS (FTPES).
+	TLSExplicit
+	// TLSImplicit connects with TLS from the start (FTPS, usually on port 990).
+	TLSImplicit
+)
+
+// Disk is the FTP disk.
+// It keeps a pool of connections and reconnects automatically if a connection is lost.
+// Data connections are always opened in passive mode.
+type Disk struct {
+	Config Config
+	pool   *pool
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// Addr is the address of the FTP server (host:port).
+	Addr     string
+	Username string
+	Password string
+	TLSMode  TLSMode
+	// TLS is the TLS configuration for TLSExplicit and TLSImplicit.
+	TLS *tls.Config
+	// Root is the directory on the server that contains the files of the disk.
+	Root     string
+	PoolSize int
+	// IdleTimeout is the time after which idle connections are closed.
+	// Most servers close idle connections after a few minutes.
+	IdleTimeout time.Duration
+	// DisableEPSV makes the disk use PASV instead of EPSV for passive data connections.
+	DisableEPSV bool
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// Credentials sets the user and password. Without credentials, the disk logs in anonymously.
+func Credentials(username, password string) Option {
+	return func(cfg *Config) {
+		cfg.Username = username
+		cfg.Password = password
+	}
+}
+
+// TLS encrypts the connections in the given mode.
+// If tlsConfig is nil or has no ServerName, the host of the server address is used as the ServerName.
+func TLS(mode TLSMode, tlsConfig *tls.Config) Option {
+	return func(cfg *Config) {
+		cfg.TLSMode = mode
+		cfg.TLS = tlsConfig
+	}
+}
+
+// Root sets the directory on the server that contains the files of the disk.
+// Paths are resolved relative to the directory and cannot leave it.
+func Root(dir string) Option {
+	return func(cfg *Config) {
+		cfg.Root = dir
+	}
+}
+
+// PoolSize sets the maximum number of open connections.
+func PoolSize(size int) Option {
+	return func(cfg *Config) {
+		cfg.PoolSize = size
+	}
+}
+
+// IdleTimeout sets the time after which idle connections are closed.
+// A timeout <= 0 keeps idle connections open until the disk is closed.
+func IdleTimeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.IdleTimeout = timeout
+	}
+}
+
+// DisableEPSV makes the disk use PASV instead of EPSV for passive data connections.
+// Some servers and firewalls don't support EPSV.
+func DisableEPSV() Option {
+	return func(cfg *Config) {
+		cfg.DisableEPSV = true
+	}
+}
+
+// NewDisk creates a new FTP disk for the server at addr.
+// Connections are established when needed.
+func NewDisk(addr string, options ...Option) *Disk {
+	cfg := Config{
+		Addr:        addr,
+		Username:    "anonymous",
+		Password:    "anonymous",
+		PoolSize:    DefaultPoolSize,
+		IdleTimeout: DefaultIdleTimeout,
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	if cfg.PoolSize < 1 {
+		cfg.PoolSize = 1
+	}
+
+	if cfg.TLSMode != TLSNone {
+		if cfg.TLS == nil {
+			cfg.TLS = &tls.Config{}
+		}
+		if cfg.TLS.ServerName == "" {
+			cfg.TLS = cfg.TLS.Clone()
+			cfg.TLS.ServerName, _, _ = net.SplitHostPort(addr)
+		}
+	}
+
+	d := &Disk{Config: cfg}
+	d.pool = newPool(cfg.PoolSize, cfg.IdleTimeout, d.dial)
+
+	return d
+}
+
+func (d *Disk) dial(ctx context.Context) (*ftp.ServerConn, error) {
+	options := []ftp.DialOption{
+		ftp.DialWithContext(ctx),
+		ftp.DialWithDisabledEPSV(d.Config.DisableEPSV),
+	}
+
+	switch d.Config.TLSMode {
+	case TLSExplicit:
+		options = append(options, ftp.DialWithExplicitTLS(d.Config.TLS))
+	case TLSImplicit:
+		options = append(options, ftp.DialWithTLS(d.Config.TLS))
+	}
+
+	c, err := ftp.Dial(d.Config.Addr, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Login(d.Config.Username, d.Config.Password); err != nil {
+		c.Quit()
+		return nil, err
+	}
+
+	// Paths of the disk are relative to the working directory.
+	if d.Config.Root != "" {
+		if err := c.ChangeDir(d.Config.Root); err != nil {
+			c.Quit()
+			return nil, fmt.Errorf("change to root directory: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// do calls fn with a pooled connection.
+// If retry is true and the connection was lost, fn is called again with a new connection.
+func (d *Disk) do(ctx context.Context, retry bool, fn func(*ftp.ServerConn) error) error {
+	for {
+		c, err := d.pool.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = fn(c)
+		d.pool.put(c, err)
+
+		if !retry || !isConnError(err) {
+			return err
+		}
+		retry = false
+	}
+}
+
+// path returns the path on the server for a path of the disk.
+// The path is relative to the working directory, which is the root directory of the disk.
+func (d *Disk) path(p string) string {
+	if p = strings.TrimPrefix(path.Join("/", p), "/"); p == "" {
+		return "."
+	}
+	return p
+}
+
+// Put writes b to the file at the given path.
+// Missing directories are created.
+func (d *Disk) Put(ctx context.Context, p string, b []byte) error {
+	return d.do(ctx, true, func(c *ftp.ServerConn) error {
+		return d.stor(c, p, bytes.NewReader(b))
+	})
+}
+
+// PutReader writes r to the file at the given path.
+// Missing directories are created.
+func (d *Disk) PutReader(ctx context.Context, p string, r io.Reader) error {
+	return d.do(ctx, false, func(c *ftp.ServerConn) error {
+		return d.stor(c, p, r)
+	})
+}
+
+// stor creates the missing directories and uploads r.
+// The directories are created before the upload because servers use different reply codes
+// for missing directories and some of them don't close the data connection of a rejected upload.
+func (d *Disk) stor(c *ftp.ServerConn, p string, r io.Reader) error {
+	if err := mkdirAll(c, path.Dir(d.path(p))); err != nil {
+		return err
+	}
+	return c.Stor(d.path(p), r)
+}
+
+// mkdirAll creates the directory at the given path and all missing parents.
+// Replies of the server are ignored because servers don't report existing
+// directories consistently. If a directory cannot be created, the upload fails.
+func mkdirAll(c *ftp.ServerConn, dir string) error {
+	if dir == "." || dir == "/" {
+		return nil
+	}
+
+	if err := mkdirAll(c, path.Dir(dir)); err != nil {
+		return err
+	}
+
+	if err := c.MakeDir(dir); err != nil && !isReply(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves the file at the given path.
+func (d *Disk) Get(ctx context.Context, p string) ([]byte, error) {
+	var b []byte
+	err := d.do(ctx, true, func(c *ftp.ServerConn) error {
+		resp, err := c.Retr(d.path(p))
+		if err != nil {
+			return err
+		}
+
+		b, err = io.ReadAll(resp)
+		if cerr := resp.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	})
+	return b, err
+}
+
+// GetReader returns a reader for the file at the given path.
+// The connection is returned to the pool when the reader is closed.
+func (d *Disk) GetReader(ctx context.Context, p string) (io.ReadCloser, error) {
+	c, err := d.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Retr(d.path(p))
+	if err != nil {
+		d.pool.put(c, err)
+		return nil, err
+	}
+
+	return &pooledReader{Response: resp, pool: d.pool, conn: c}, nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(ctx context.Context, p string) error {
+	return d.do(ctx, true, func(c *ftp.ServerConn) error {
+		return c.Delete(d.path(p))
+	})
+}
+
+// List returns the paths of all files whose path begins with prefix.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	dir := path.Dir("/" + prefix)
+	if strings.HasSuffix(prefix, "/") {
+		dir = path.Join("/", prefix)
+	}
+
+	var paths []string
+	err := d.do(ctx, true, func(c *ftp.ServerConn) error {
+		paths = nil
+
+		err := walk(c, d.path(dir), func(p string) {
+			if strings.HasPrefix(p, prefix) {
+				paths = append(paths, p)
+			}
+		})
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	})
+
+	return paths, err
+}
+
+// walk calls fn with the path of every file in dir and its subdirectories.
+func walk(c *ftp.ServerConn, dir string, fn func(string)) error {
+	entries, err := c.List(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+
+		p := path.Join(dir, entry.Name)
+
+		switch entry.Type {
+		case ftp.EntryTypeFolder:
+			if err := walk(c, p, fn); err != nil {
+				return err
+			}
+		case ftp.EntryTypeFile:
+			fn(p)
+		}
+	}
+
+	return nil
+}
+
+// Stat returns information about the file at the given path.
+func (d *Disk) Stat(ctx context.Context, p string) (godrive.FileInfo, error) {
+	var info godrive.FileInfo
+	err := d.do(ctx, true, func(c *ftp.ServerConn) error {
+		size, err := c.FileSize(d.path(p))
+		if err != nil {
+			return err
+		}
+
+		info = godrive.FileInfo{
+			Path: p,
+			Size: size,
+		}
+
+		if c.IsGetTimeSupported() {
+			info.ModTime, err = c.GetTime(d.path(p))
+			return err
+		}
+
+		// Without MDTM, the modification time is taken from the directory listing,
+		// which is often precise only to the minute.
+		if entries, err := c.List(d.path(p)); err == nil && len(entries) == 1 {
+			info.ModTime = entries[0].Time
+		}
+
+		return nil
+	})
+	return info, err
+}
+
+// CheckHealth checks if the server is reachable and the user can log in.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	return d.do(ctx, true, func(c *ftp.ServerConn) error {
+		return c.NoOp()
+	})
+}
+
+// Close closes all idle connections.
+// Connections that are in use are closed when they are released.
+func (d *Disk) Close() error {
+	return d.pool.close()
+}
+
+// isReply determines if err is an error reply of the server.
+func isReply(err error) bool {
+	var perr *textproto.Error
+	return errors.As(err, &perr)
+}
+
+// isNotFound determines if err is a "file unavailable" reply of the server,
+// which servers return for missing files and directories.
+func isNotFound(err error) bool {
+	var perr *textproto.Error
+	return errors.As(err, &perr) && perr.Code == ftp.StatusFileUnavailable
+}
+
+// isConnError determines if err means that the connection is unusable.
+func isConnError(err error) bool {
+	var perr *textproto.Error
+	if errors.As(err, &perr) {
+		return perr.Code == ftp.StatusNotAvailable
+	}
+
+	var nerr net.Error
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.As(err, &nerr)
+}
+
+type pooledReader struct {
+	*ftp.Response
+	pool *pool
+	conn *ftp.ServerConn
+	once sync.Once
+}
+
+// Close closes the file and releases the connection. Only the first call has an effect.
+func (r *pooledReader) Close() error {
+	var err error
+	r.once.Do(func() {
+		err = r.Response.Close()
+		r.pool.put(r.conn, err)
+	})
+	return err
+}
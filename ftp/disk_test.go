@@ -0,0 +1,250 @@
+package ftp_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/ftp"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDisk(t *testing.T, srv *testServer, config map[string]interface{}) *ftp.Disk {
+	cfg := map[string]interface{}{
+		"host":     srv.Host,
+		"port":     srv.Port,
+		"user":     testUser,
+		"password": testPassword,
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := ftp.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { disk.(*ftp.Disk).Close() })
+
+	return disk.(*ftp.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, nil)
+	testDisk(t, srv, disk)
+}
+
+func TestDisk_explicitTLS(t *testing.T) {
+	srv := newTestServer(t, "explicit")
+	disk := newTestDisk(t, srv, map[string]interface{}{
+		"tls":                "explicit",
+		"insecureSkipVerify": true,
+	})
+	testDisk(t, srv, disk)
+}
+
+func TestDisk_implicitTLS(t *testing.T) {
+	srv := newTestServer(t, "implicit")
+	disk := newTestDisk(t, srv, map[string]interface{}{
+		"tls":                "implicit",
+		"insecureSkipVerify": true,
+	})
+	testDisk(t, srv, disk)
+}
+
+func testDisk(t *testing.T, srv *testServer, disk *ftp.Disk) {
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/sub/file2.txt", strings.NewReader("content2")))
+	assert.Nil(t, disk.Put(ctx, "other.txt", []byte("other")))
+
+	b, err := os.ReadFile(filepath.Join(srv.Dir, "dir", "file1.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	b, err = disk.Get(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	r, err := disk.GetReader(ctx, "dir/sub/file2.txt")
+	assert.Nil(t, err)
+	b, _ = io.ReadAll(r)
+	assert.Nil(t, r.Close())
+	assert.Equal(t, []byte("content2"), b)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+	paths, err = disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"dir/file1.txt", "dir/sub/file2.txt", "other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "oth")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "missing/")
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+
+	info, err := disk.Stat(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.False(t, info.ModTime.IsZero())
+
+	assert.Nil(t, disk.Delete(ctx, "other.txt"))
+	_, err = disk.Get(ctx, "other.txt")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_root(t *testing.T) {
+	srv := newTestServer(t, "")
+	if err := os.Mkdir(filepath.Join(srv.Dir, "upload"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	disk := newTestDisk(t, srv, map[string]interface{}{"root": "/upload"})
+
+	assert.Nil(t, disk.Put(context.Background(), "../../escape.txt", []byte("content")))
+
+	_, err := os.Stat(filepath.Join(srv.Dir, "upload", "escape.txt"))
+	assert.Nil(t, err)
+}
+
+func TestDisk_missingRoot(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, map[string]interface{}{"root": "/missing"})
+
+	assert.ErrorContains(t, disk.CheckHealth(context.Background()), "change to root directory")
+}
+
+func TestDisk_wrongPassword(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, map[string]interface{}{"password": "wrong"})
+
+	assert.NotNil(t, disk.Put(context.Background(), "file.txt", []byte("content")))
+}
+
+func TestDisk_pool(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, map[string]interface{}{"poolSize": 2})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, srv.dialCount(), 2)
+}
+
+func TestDisk_idleTimeout(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, map[string]interface{}{"idleTimeout": "50ms"})
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, disk.CheckHealth(ctx))
+	assert.Equal(t, 1, srv.dialCount())
+
+	time.Sleep(150 * time.Millisecond)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+	assert.Equal(t, 2, srv.dialCount())
+}
+
+func TestDisk_GetReader_closeTwice(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, map[string]interface{}{"poolSize": 1})
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+
+	r, err := disk.GetReader(ctx, "file.txt")
+	assert.Nil(t, err)
+	_, err = io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+
+	// A second Close must not release the connection again.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Close()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("second Close blocks")
+	}
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+	assert.Equal(t, 1, srv.dialCount())
+}
+
+func TestDisk_reconnect(t *testing.T) {
+	srv := newTestServer(t, "")
+	disk := newTestDisk(t, srv, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	srv.dropConnections()
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+	assert.Equal(t, 2, srv.dialCount())
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := ftp.NewAutoWire(ctx, map[string]interface{}{})
+	assert.Equal(t, ftp.InvalidConfigValueError{
+		Key:     "host",
+		Details: "host must be set",
+	}, err)
+
+	_, err = ftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test", "tls": "yes"})
+	assert.Equal(t, ftp.InvalidConfigValueError{
+		Key:     "tls",
+		Details: "tls must be 'none', 'explicit' or 'implicit' but it is 'yes'",
+	}, err)
+
+	_, err = ftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test", "idleTimeout": "soon"})
+	assert.Equal(t, ftp.InvalidConfigValueError{
+		Key:     "idleTimeout",
+		Details: "idle timeout must be a duration but it is 'soon'",
+	}, err)
+
+	disk, err := ftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test"})
+	assert.Nil(t, err)
+	assert.Equal(t, "example.test:21", disk.(*ftp.Disk).Config.Addr)
+	assert.Equal(t, "anonymous", disk.(*ftp.Disk).Config.Username)
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapStreaming|godrive.CapListing|godrive.CapStat|godrive.CapHealth))
+
+	disk, err = ftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test", "tls": "implicit"})
+	assert.Nil(t, err)
+	assert.Equal(t, "example.test:990", disk.(*ftp.Disk).Config.Addr)
+	assert.Equal(t, ftp.TLSImplicit, disk.(*ftp.Disk).Config.TLSMode)
+	assert.Equal(t, "example.test", disk.(*ftp.Disk).Config.TLS.ServerName)
+}
@@ -0,0 +1,143 @@
+package ftp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jlaffaye/ftp"
+)
+
+// ErrClosed is returned by the operations of a closed Disk.
+var ErrClosed = errors.New("ftp disk closed")
+
+type idleConn struct {
+	conn  *ftp.ServerConn
+	since time.Time
+}
+
+// pool limits the number of open connections and reuses idle connections.
+// Connections that are idle for longer than the idle timeout are closed.
+type pool struct {
+	dial        func(context.Context) (*ftp.ServerConn, error)
+	sem         chan struct{}
+	idleTimeout time.Duration
+
+	mux    sync.Mutex
+	idle   []idleConn
+	timer  *time.Timer
+	closed bool
+}
+
+func newPool(size int, idleTimeout time.Duration, dial func(context.Context) (*ftp.ServerConn, error)) *pool {
+	return &pool{
+		dial:        dial,
+		sem:         make(chan struct{}, size),
+		idleTimeout: idleTimeout,
+	}
+}
+
+// get returns an idle connection or dials a new one.
+// It blocks until a connection is available or ctx is canceled.
+func (p *pool) get(ctx context.Context) (*ftp.ServerConn, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		<-p.sem
+		return nil, ErrClosed
+	}
+	p.closeExpired()
+	if n := len(p.idle); n > 0 {
+		c := p.idle[n-1].conn
+		p.idle = p.idle[:n-1]
+		p.mux.Unlock()
+		return c, nil
+	}
+	p.mux.Unlock()
+
+	c, err := p.dial(ctx)
+	if err != nil {
+		<-p.sem
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// put releases a connection. If err means the connection is unusable, the connection is closed.
+func (p *pool) put(c *ftp.ServerConn, err error) {
+	defer func() { <-p.sem }()
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.closed || isConnError(err) {
+		c.Quit()
+		return
+	}
+
+	p.idle = append(p.idle, idleConn{conn: c, since: time.Now()})
+
+	if p.idleTimeout > 0 && p.timer == nil {
+		p.timer = time.AfterFunc(p.idleTimeout, p.expire)
+	}
+}
+
+// expire closes expired idle connections and reschedules itself while connections are idle.
+func (p *pool) expire() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.timer = nil
+	if p.closed {
+		return
+	}
+
+	p.closeExpired()
+
+	// The first idle connection is the oldest one.
+	if len(p.idle) > 0 {
+		p.timer = time.AfterFunc(time.Until(p.idle[0].since.Add(p.idleTimeout)), p.expire)
+	}
+}
+
+// closeExpired closes the connections that are idle for longer than the idle timeout.
+// p.mux must be locked by the caller.
+func (p *pool) closeExpired() {
+	if p.idleTimeout <= 0 {
+		return
+	}
+
+	deadline := time.Now().Add(-p.idleTimeout)
+
+	var n int
+	for n < len(p.idle) && !p.idle[n].since.After(deadline) {
+		p.idle[n].conn.Quit()
+		n++
+	}
+	p.idle = p.idle[n:]
+}
+
+func (p *pool) close() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.closed = true
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	for _, c := range p.idle {
+		c.conn.Quit()
+	}
+	p.idle = nil
+
+	return nil
+}
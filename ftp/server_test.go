@@ -0,0 +1,210 @@
+package ftp_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"goftp.io/server/v2"
+	"goftp.io/server/v2/driver/file"
+)
+
+// testServer is an in-process FTP server that stores the files in a temporary directory.
+type testServer struct {
+	Host string
+	Port int
+	Dir  string
+
+	mux   sync.Mutex
+	conns []net.Conn
+	dials int
+}
+
+const (
+	testUser     = "godrive"
+	testPassword = "secret"
+)
+
+// newTestServer starts a test server. tlsMode is "", "explicit" or "implicit".
+func newTestServer(t *testing.T, tlsMode string) *testServer {
+	dir := t.TempDir()
+	srv := &testServer{
+		Host: "127.0.0.1",
+		Dir:  filepath.Join(dir, "files"),
+	}
+	if err := os.Mkdir(srv.Dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	driver, err := file.NewDriver(srv.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &server.Options{
+		Driver:   driver,
+		Auth:     &server.SimpleAuth{Name: testUser, Password: testPassword},
+		Perm:     server.NewSimplePerm("godrive", "godrive"),
+		Hostname: srv.Host,
+		Logger:   &server.DiscardLogger{},
+	}
+
+	if tlsMode != "" {
+		opts.TLS = true
+		opts.ExplicitFTPS = tlsMode == "explicit"
+		opts.CertFile, opts.KeyFile = writeCertificate(t, dir)
+		// The server initializes TLS only in ListenAndServe, so TLS servers cannot use a custom listener.
+		opts.Port = freePort(t)
+	}
+
+	s, err := server.NewServer(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Shutdown() })
+
+	// The server accepts PBSZ and PROT only after AUTH TLS, but clients send them on implicit TLS connections too.
+	if tlsMode == "implicit" {
+		commands := make(map[string]server.Command, len(s.Commands))
+		for name, cmd := range s.Commands {
+			commands[name] = cmd
+		}
+		commands["PBSZ"] = okCommand{}
+		commands["PROT"] = okCommand{}
+		s.Commands = commands
+	}
+
+	if tlsMode != "" {
+		srv.Port = opts.Port
+		go s.ListenAndServe()
+		waitForServer(t, srv.Addr())
+		return srv
+	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(srv.Host, "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Port = l.Addr().(*net.TCPAddr).Port
+	go s.Serve(&trackingListener{Listener: l, srv: srv})
+
+	return srv
+}
+
+func (srv *testServer) Addr() string {
+	return net.JoinHostPort(srv.Host, strconv.Itoa(srv.Port))
+}
+
+// dropConnections closes all open connections of the server.
+func (srv *testServer) dropConnections() {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	for _, conn := range srv.conns {
+		conn.Close()
+	}
+	srv.conns = nil
+}
+
+func (srv *testServer) dialCount() int {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	return srv.dials
+}
+
+type trackingListener struct {
+	net.Listener
+	srv *testServer
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	l.srv.mux.Lock()
+	l.srv.conns = append(l.srv.conns, conn)
+	l.srv.dials++
+	l.srv.mux.Unlock()
+
+	return conn, nil
+}
+
+// okCommand accepts a command without doing anything.
+type okCommand struct{}
+
+func (okCommand) IsExtend() bool     { return false }
+func (okCommand) RequireParam() bool { return true }
+func (okCommand) RequireAuth() bool  { return false }
+
+func (okCommand) Execute(sess *server.Session, _ string) {
+	sess.WriteMessage(200, "OK")
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func waitForServer(t *testing.T, addr string) {
+	for i := 0; i < 100; i++ {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("ftp server at %s did not start", addr)
+}
+
+// writeCertificate writes a self-signed certificate for 127.0.0.1 to dir.
+func writeCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "godrive"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
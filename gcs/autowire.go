@@ -15,9 +15,41 @@ const (
 	Provider = "gcs"
 )
 
+// Schema is the autowire configuration schema for Google Cloud Storage disks.
+var Schema = godrive.ConfigSchema{
+	Description: "Google Cloud Storage",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "serviceAccount",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Path to the service account JSON file.",
+		},
+		{
+			Key:         "bucket",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Name of the storage bucket.",
+		},
+		{
+			Key:         "public",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Make uploaded files publicly accessible.",
+		},
+		{
+			Key:         "urlTemplate",
+			Type:        godrive.TypeString,
+			Default:     DefaultURLTemplate,
+			Description: "Template for public URLs. Receives .Bucket and .Path.",
+		},
+	},
+}
+
 // Register registeres Google Cloud Storage as a provider for the disk autowire.
 func Register(cfg *godrive.AutoWireConfig) {
 	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
 }
 
 // NewAutoWire creates a new Google Cloud Storage disk from an autowire configuration.
@@ -60,12 +92,24 @@ func NewAutoWire(ctx context.Context, cfg map[string]interface{}) (godrive.Disk,
 	}
 	public, _ := rpublic.(bool)
 
+	urlTemplate := DefaultURLTemplate
+	if rtpl, ok := cfg["urlTemplate"]; ok {
+		tpl, ok := rtpl.(string)
+		if !ok || tpl == "" {
+			return nil, InvalidConfigValueError{
+				Key:     "urlTemplate",
+				Details: fmt.Sprintf("url template must be a non-empty string but it is '%T'", rtpl),
+			}
+		}
+		urlTemplate = tpl
+	}
+
 	client, err := storage.NewClient(ctx, option.WithCredentialsFile(serviceAccountPath))
 	if err != nil {
 		return nil, err
 	}
 
-	return NewDisk(client, bucket, Public(public)), nil
+	return NewDisk(client, bucket, Public(public), URLTemplate(urlTemplate)), nil
 }
 
 // InvalidConfigValueError means the autowire configuration has an invalid config value.
@@ -4,12 +4,19 @@ package gcs
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	gcs "cloud.google.com/go/storage"
+	"github.com/bounoable/godrive"
+	"google.golang.org/api/iterator"
 )
 
 const (
@@ -136,3 +143,210 @@ func (d *Disk) GetURL(_ context.Context, path string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// GetReader returns a reader for the file at the given path.
+func (d *Disk) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	return d.Client.Bucket(d.Config.Bucket).Object(path).NewReader(ctx)
+}
+
+// List returns the paths of all files whose path begins with prefix.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	it := d.Client.Bucket(d.Config.Bucket).Objects(ctx, &gcs.Query{Prefix: prefix})
+
+	var paths []string
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return paths, nil
+		}
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, attrs.Name)
+	}
+}
+
+// Stat returns information about the file at the given path.
+func (d *Disk) Stat(ctx context.Context, path string) (godrive.FileInfo, error) {
+	attrs, err := d.Client.Bucket(d.Config.Bucket).Object(path).Attrs(ctx)
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+
+	return godrive.FileInfo{
+		Path:        attrs.Name,
+		Size:        attrs.Size,
+		ContentType: attrs.ContentType,
+		ModTime:     attrs.Updated,
+		Metadata:    attrs.Metadata,
+	}, nil
+}
+
+// GetSignedURL returns a V4 signed URL for the file at the given path that expires after expiry.
+// The client must be authorized with credentials that are able to sign URLs.
+func (d *Disk) GetSignedURL(_ context.Context, path string, expiry time.Duration) (string, error) {
+	return d.Client.Bucket(d.Config.Bucket).SignedURL(path, &gcs.SignedURLOptions{
+		Method:  "GET",
+		Expires: time.Now().Add(expiry),
+		Scheme:  gcs.SigningSchemeV4,
+	})
+}
+
+// Copy copies the file at src to dst.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	bucket := d.Client.Bucket(d.Config.Bucket)
+	obj := bucket.Object(dst)
+
+	if _, err := obj.CopierFrom(bucket.Object(src)).Run(ctx); err != nil {
+		return err
+	}
+
+	if d.Config.Public {
+		return d.makePublic(ctx, obj)
+	}
+
+	return nil
+}
+
+// GetMetadata returns the custom metadata of the file at the given path.
+func (d *Disk) GetMetadata(ctx context.Context, path string) (map[string]string, error) {
+	attrs, err := d.Client.Bucket(d.Config.Bucket).Object(path).Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return attrs.Metadata, nil
+}
+
+// SetMetadata replaces the custom metadata of the file at the given path.
+func (d *Disk) SetMetadata(ctx context.Context, path string, metadata map[string]string) error {
+	obj := d.Client.Bucket(d.Config.Bucket).Object(path)
+
+	attrs, err := obj.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Updates are merged into the existing metadata, keys are removed by setting them to "".
+	update := make(map[string]string, len(metadata)+len(attrs.Metadata))
+	for k := range attrs.Metadata {
+		update[k] = ""
+	}
+	for k, v := range metadata {
+		update[k] = v
+	}
+
+	_, err = obj.If(gcs.Conditions{MetagenerationMatch: attrs.Metageneration}).Update(ctx, gcs.ObjectAttrsToUpdate{
+		Metadata: update,
+	})
+
+	return err
+}
+
+// CheckHealth checks if the bucket exists and is accessible with the credentials of the disk.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	_, err := d.Client.Bucket(d.Config.Bucket).Attrs(ctx)
+	return err
+}
+
+// ListVersions returns the generations of the object at the given path, newest first.
+// Noncurrent generations are only kept if object versioning is enabled on the bucket.
+func (d *Disk) ListVersions(ctx context.Context, path string) ([]godrive.Version, error) {
+	it := d.Client.Bucket(d.Config.Bucket).Objects(ctx, &gcs.Query{
+		Prefix:   path,
+		Versions: true,
+	})
+
+	var versions []godrive.Version
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return versions, err
+		}
+
+		if attrs.Name != path {
+			continue
+		}
+
+		versions = append(versions, godrive.Version{
+			ID:      strconv.FormatInt(attrs.Generation, 10),
+			Size:    attrs.Size,
+			ModTime: attrs.Created,
+			Latest:  attrs.Deleted.IsZero(),
+		})
+	}
+
+	// Generations are unique and increase with every write, so they order versions with the
+	// same creation time.
+	sort.SliceStable(versions, func(i, j int) bool {
+		if !versions[i].ModTime.Equal(versions[j].ModTime) {
+			return versions[i].ModTime.After(versions[j].ModTime)
+		}
+		gi, _ := strconv.ParseInt(versions[i].ID, 10, 64)
+		gj, _ := strconv.ParseInt(versions[j].ID, 10, 64)
+		return gi > gj
+	})
+
+	return versions, nil
+}
+
+// GetVersion retrieves the generation of the object at the given path.
+func (d *Disk) GetVersion(ctx context.Context, path, versionID string) ([]byte, error) {
+	obj, err := d.generation(path, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := obj.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// RestoreVersion copies the generation of the object at the given path over the live object,
+// which creates a new generation.
+func (d *Disk) RestoreVersion(ctx context.Context, path, versionID string) error {
+	src, err := d.generation(path, versionID)
+	if err != nil {
+		return err
+	}
+
+	obj := d.Client.Bucket(d.Config.Bucket).Object(path)
+	if _, err := obj.CopierFrom(src).Run(ctx); err != nil {
+		return err
+	}
+
+	if d.Config.Public {
+		return d.makePublic(ctx, obj)
+	}
+
+	return nil
+}
+
+// DeleteVersion permanently deletes the generation of the object at the given path.
+func (d *Disk) DeleteVersion(ctx context.Context, path, versionID string) error {
+	obj, err := d.generation(path, versionID)
+	if err != nil {
+		return err
+	}
+	return obj.Delete(ctx)
+}
+
+func (d *Disk) generation(path, versionID string) (*gcs.ObjectHandle, error) {
+	gen, err := strconv.ParseInt(versionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generation '%s': %w", versionID, err)
+	}
+	return d.Client.Bucket(d.Config.Bucket).Object(path).Generation(gen), nil
+}
+
+// Close closes the storage client of the disk.
+// Don't close the disk if the client is shared with other disks.
+func (d *Disk) Close() error {
+	return d.Client.Close()
+}
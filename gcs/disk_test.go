@@ -0,0 +1,115 @@
+package gcs_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/bounoable/godrive/gcs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+)
+
+// fakeObject serves the metadata of a single object like the JSON API of Cloud Storage:
+// updates are merged into the existing metadata and keys with empty values are removed.
+type fakeObject struct {
+	mux      sync.Mutex
+	metadata map[string]string
+}
+
+func (o *fakeObject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	if !strings.HasSuffix(r.URL.Path, "/b/bucket/o/file.txt") {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Method == http.MethodPatch {
+		var update struct {
+			Metadata map[string]string `json:"metadata"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for k, v := range update.Metadata {
+			if v == "" {
+				delete(o.metadata, k)
+				continue
+			}
+			o.metadata[k] = v
+		}
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"bucket":         "bucket",
+		"name":           "file.txt",
+		"metageneration": "1",
+		"metadata":       o.metadata,
+	})
+}
+
+func TestDisk_SetMetadata(t *testing.T) {
+	obj := &fakeObject{metadata: map[string]string{"owner": "bob", "team": "a"}}
+	srv := httptest.NewServer(obj)
+	defer srv.Close()
+
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx, option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	disk := gcs.NewDisk(client, "bucket")
+
+	assert.Nil(t, disk.SetMetadata(ctx, "file.txt", map[string]string{"team": "b"}))
+	md, err := disk.GetMetadata(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, md)
+
+	assert.Nil(t, disk.SetMetadata(ctx, "file.txt", nil))
+	md, err = disk.GetMetadata(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Empty(t, md)
+}
+
+func TestDisk_ListVersions(t *testing.T) {
+	// Versions are listed in the order of their generations.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/b/bucket/o") || r.URL.Query().Get("versions") != "true" {
+			http.NotFound(w, r)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "storage#objects",
+			"items": []map[string]interface{}{
+				{"bucket": "bucket", "name": "file.txt", "generation": "1", "size": "3", "timeCreated": "2024-01-01T00:00:00Z", "timeDeleted": "2024-01-01T00:00:00Z"},
+				{"bucket": "bucket", "name": "file.txt", "generation": "2", "size": "5", "timeCreated": "2024-01-01T00:00:00Z"},
+				{"bucket": "bucket", "name": "file.txt.bak", "generation": "3", "size": "1", "timeCreated": "2024-01-01T00:00:00Z"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx, option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	disk := gcs.NewDisk(client, "bucket")
+
+	versions, err := disk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, "2", versions[0].ID)
+	assert.True(t, versions[0].Latest)
+	assert.Equal(t, "1", versions[1].ID)
+	assert.False(t, versions[1].Latest)
+}
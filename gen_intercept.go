@@ -0,0 +1,65 @@
+//go:build ignore
+
+// gen_intercept generates the types of intercepted Disks for every combination
+// of optional interfaces (intercept_gen.go).
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// interfaces are the optional interfaces in the order of their Capability bits.
+var interfaces = []string{
+	"URLProvider",
+	"Streamer",
+	"Lister",
+	"Stater",
+	"SignedURLProvider",
+	"Copier",
+	"MetadataProvider",
+	"HealthChecker",
+	"Versioner",
+}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_intercept.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package godrive\n\n")
+	buf.WriteString("// interceptedTypes returns an intercepted Disk that implements exactly the optional\n")
+	buf.WriteString("// interfaces of the capabilities it is indexed by.\n")
+	buf.WriteString("var interceptedTypes = [capAll + 1]func(*interceptedDisk) Disk{\n")
+	buf.WriteString("0: func(d *interceptedDisk) Disk { return d },\n")
+
+	for caps := 1; caps < 1<<len(interfaces); caps++ {
+		var names []string
+		for i, name := range interfaces {
+			if caps&(1<<i) != 0 {
+				names = append(names, "intercepted"+name)
+			}
+		}
+
+		fmt.Fprintf(&buf, "%d: func(d *interceptedDisk) Disk {\nreturn struct {\n*interceptedDisk\n", caps)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "%s\n", name)
+		}
+		buf.WriteString("}{d")
+		for _, name := range names {
+			fmt.Fprintf(&buf, ", %s{d}", name)
+		}
+		buf.WriteString("}\n},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("intercept_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,140 @@
+package godrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HealthStatus is the health status of a Disk.
+type HealthStatus string
+
+const (
+	// HealthUp means the health check of the Disk succeeded.
+	HealthUp = HealthStatus("up")
+	// HealthDown means the health check of the Disk failed or the Disk could not be created.
+	HealthDown = HealthStatus("down")
+	// HealthUnknown means the Disk does not implement HealthChecker.
+	HealthUnknown = HealthStatus("unknown")
+)
+
+// HealthReport is the result of (*Manager).Health().
+type HealthReport struct {
+	// Healthy is false if any Disk is down.
+	Healthy bool         `json:"healthy"`
+	Disks   []DiskHealth `json:"disks"`
+}
+
+// DiskHealth is the health of a single Disk.
+type DiskHealth struct {
+	Name    string        `json:"name"`
+	Status  HealthStatus  `json:"status"`
+	Latency time.Duration `json:"-"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the latency in milliseconds.
+func (h DiskHealth) MarshalJSON() ([]byte, error) {
+	type diskHealth DiskHealth
+	return json.Marshal(struct {
+		diskHealth
+		LatencyMS float64 `json:"latencyMs"`
+	}{
+		diskHealth: diskHealth(h),
+		LatencyMS:  float64(h.Latency) / float64(time.Millisecond),
+	})
+}
+
+// Health checks the health of all Disks concurrently and returns the status and latency of each Disk.
+// Disks that don't implement HealthChecker are reported as HealthUnknown and don't affect
+// the overall health. Lazily initialized Disks (see LazyInit) are created by the health check.
+func (m *Manager) Health(ctx context.Context) HealthReport {
+	names := m.names()
+	report := HealthReport{
+		Healthy: true,
+		Disks:   make([]DiskHealth, len(names)),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(names))
+	for i, name := range names {
+		go func(i int, name string) {
+			defer wg.Done()
+			report.Disks[i] = m.checkHealth(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	for _, h := range report.Disks {
+		if h.Status == HealthDown {
+			report.Healthy = false
+		}
+	}
+
+	return report
+}
+
+func (m *Manager) checkHealth(ctx context.Context, name string) DiskHealth {
+	h := DiskHealth{Name: name}
+	start := time.Now()
+
+	disk, err := m.Disk(name)
+	if err == nil && !Capabilities(disk).Has(CapHealth) {
+		h.Status = HealthUnknown
+		return h
+	}
+
+	if err == nil {
+		err = disk.(HealthChecker).CheckHealth(ctx)
+	}
+	h.Latency = time.Since(start)
+
+	if err != nil {
+		h.Status = HealthDown
+		h.Error = err.Error()
+		return h
+	}
+
+	h.Status = HealthUp
+	return h
+}
+
+// names returns the sorted names of all configured Disks, including Disks that are not yet initialized.
+func (m *Manager) names() []string {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	names := make([]string, 0, len(m.disks)+len(m.pending))
+	for name := range m.disks {
+		names = append(names, name)
+	}
+	for name := range m.pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// HealthHandler returns an http.Handler that serves the health of the Disks of m as JSON
+// (see (*Manager).Health). It responds with status 200 if all Disks are healthy and with
+// status 503 otherwise, so it can be used as a readiness probe.
+func HealthHandler(m *Manager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := m.Health(r.Context())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+
+		if report.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,97 @@
+package godrive_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+type healthDisk struct {
+	*memdisk.Disk
+	err error
+}
+
+func (d healthDisk) CheckHealth(context.Context) error {
+	return d.err
+}
+
+func TestManager_Health(t *testing.T) {
+	m := godrive.New()
+	m.Configure("up", healthDisk{Disk: memdisk.New()})
+	m.Configure("down", healthDisk{Disk: memdisk.New(), err: errors.New("bucket not found")})
+	m.Configure("plain", memdisk.New())
+
+	report := m.Health(context.Background())
+
+	assert.False(t, report.Healthy)
+	assert.Len(t, report.Disks, 3)
+	assert.Equal(t, "down", report.Disks[0].Name)
+	assert.Equal(t, godrive.HealthDown, report.Disks[0].Status)
+	assert.Equal(t, "bucket not found", report.Disks[0].Error)
+	assert.Equal(t, godrive.HealthUnknown, report.Disks[1].Status)
+	assert.Equal(t, godrive.HealthUp, report.Disks[2].Status)
+
+	m.RemoveDisk("down")
+	assert.True(t, m.Health(context.Background()).Healthy)
+}
+
+func TestManager_Health_middleware(t *testing.T) {
+	m := godrive.New()
+	m.Use(godrive.Intercept(godrive.Interceptor{}))
+	m.Configure("up", healthDisk{Disk: memdisk.New()})
+	m.Configure("plain", memdisk.New())
+
+	report := m.Health(context.Background())
+	assert.Equal(t, godrive.HealthUnknown, report.Disks[0].Status)
+	assert.Equal(t, godrive.HealthUp, report.Disks[1].Status)
+}
+
+func TestManager_Health_lazyInit(t *testing.T) {
+	cfg := godrive.NewAutoWire(godrive.LazyInit())
+	cfg.RegisterProvider("broken", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		return nil, errors.New("invalid credentials")
+	}))
+	cfg.Configure("main", "broken", nil)
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+
+	report := m.Health(context.Background())
+	assert.False(t, report.Healthy)
+	assert.Equal(t, godrive.HealthDown, report.Disks[0].Status)
+	assert.Equal(t, "init disk 'main': invalid credentials", report.Disks[0].Error)
+}
+
+func TestHealthHandler(t *testing.T) {
+	m := godrive.New()
+	m.Configure("up", healthDisk{Disk: memdisk.New()})
+
+	rec := httptest.NewRecorder()
+	godrive.HealthHandler(m).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var body struct {
+		Healthy bool
+		Disks   []map[string]interface{}
+	}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.True(t, body.Healthy)
+	assert.Equal(t, "up", body.Disks[0]["name"])
+	assert.Equal(t, "up", body.Disks[0]["status"])
+	assert.Contains(t, body.Disks[0], "latencyMs")
+
+	m.Configure("down", healthDisk{Disk: memdisk.New(), err: errors.New("unreachable")})
+
+	rec = httptest.NewRecorder()
+	godrive.HealthHandler(m).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
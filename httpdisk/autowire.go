@@ -0,0 +1,166 @@
+package httpdisk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for HTTP origins.
+	Provider = "http"
+)
+
+// Schema is the autowire configuration schema for HTTP disks.
+var Schema = godrive.ConfigSchema{
+	Description: "HTTP origin",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "url",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "URL template of the files, e.g. https://cdn.example.com/assets/{path}. Without {path}, the path is appended.",
+		},
+		{
+			Key:         "headers",
+			Type:        godrive.TypeMap,
+			Description: "Headers that are added to every request.",
+		},
+		{
+			Key:         "username",
+			Type:        godrive.TypeString,
+			Description: "Username for basic authentication.",
+		},
+		{
+			Key:         "password",
+			Type:        godrive.TypeString,
+			Description: "Password for basic authentication.",
+		},
+		{
+			Key:         "token",
+			Type:        godrive.TypeString,
+			Description: "Token for bearer authentication.",
+		},
+		{
+			Key:         "writable",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Send PUT and DELETE requests for Put and Delete instead of failing.",
+		},
+		{
+			Key:         "retries",
+			Type:        godrive.TypeInt,
+			Default:     DefaultRetries,
+			Description: "Number of retries after network errors and 429 and 5xx responses.",
+		},
+		{
+			Key:         "retryDelay",
+			Type:        godrive.TypeString,
+			Default:     DefaultRetryDelay.String(),
+			Description: "Delay before the first retry, e.g. '500ms'. It doubles with every retry.",
+		},
+	},
+}
+
+// Register registers HTTP origins as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new HTTP disk from an autowire configuration.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	url, ok := cfg["url"].(string)
+	if !ok || url == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "url",
+			Details: "url must be set",
+		}
+	}
+
+	var options []Option
+
+	if rheaders, ok := cfg["headers"]; ok {
+		headers, ok := rheaders.(map[string]interface{})
+		if !ok {
+			return nil, InvalidConfigValueError{
+				Key:     "headers",
+				Details: fmt.Sprintf("headers must be a map but it is '%T'", rheaders),
+			}
+		}
+
+		for key, rval := range headers {
+			val, ok := rval.(string)
+			if !ok {
+				return nil, InvalidConfigValueError{
+					Key:     "headers",
+					Details: fmt.Sprintf("header '%s' must be a string but it is '%T'", key, rval),
+				}
+			}
+			options = append(options, Header(key, val))
+		}
+	}
+
+	if token, ok := cfg["token"].(string); ok && token != "" {
+		options = append(options, BearerAuth(token))
+	}
+
+	if username, ok := cfg["username"].(string); ok && username != "" {
+		password, _ := cfg["password"].(string)
+		options = append(options, BasicAuth(username, password))
+	}
+
+	if writable, _ := cfg["writable"].(bool); writable {
+		options = append(options, Writable())
+	}
+
+	retries := DefaultRetries
+	if rretries, ok := cfg["retries"]; ok {
+		if retries, ok = rretries.(int); !ok || retries < 0 {
+			return nil, InvalidConfigValueError{
+				Key:     "retries",
+				Details: fmt.Sprintf("retries must be a non-negative integer but it is '%v'", rretries),
+			}
+		}
+	}
+
+	delay := DefaultRetryDelay
+	if rdelay, ok := cfg["retryDelay"]; ok {
+		s, _ := rdelay.(string)
+		var err error
+		if delay, err = time.ParseDuration(s); err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "retryDelay",
+				Details: fmt.Sprintf("retry delay must be a duration but it is '%v'", rdelay),
+			}
+		}
+	}
+
+	options = append(options, Retry(retries, delay))
+
+	disk, err := NewDisk(nil, url, options...)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "url",
+			Details: err.Error(),
+		}
+	}
+
+	return disk, nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
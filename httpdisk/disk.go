@@ -0,0 +1,312 @@
+// Package httpdisk provides a disk that reads files from an HTTP origin (e.g. a CDN or a static site).
+package httpdisk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// PathPlaceholder is replaced with the path of a file in the URL template.
+	PathPlaceholder = "{path}"
+
+	// DefaultRetries is the default number of retries of a failed request.
+	DefaultRetries = 2
+	// DefaultRetryDelay is the default delay before the first retry.
+	DefaultRetryDelay = 200 * time.Millisecond
+)
+
+// Disk is the HTTP disk.
+// It is read-only unless it is created with the Writable option.
+type Disk struct {
+	Client *http.Client
+	Config Config
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// URL is the URL template of the files. PathPlaceholder is replaced with the escaped path of a file.
+	// If URL doesn't contain PathPlaceholder, the path is appended to URL.
+	URL string
+	// Header is added to every request.
+	Header   http.Header
+	Username string
+	Password string
+	// Token is used for bearer authentication instead of Username and Password.
+	Token string
+	// Writable makes Put and Delete send PUT and DELETE requests.
+	// Otherwise they return godrive.ErrReadOnly.
+	Writable bool
+	// Retries is the number of times a request is retried after a network error or a 429 or 5xx response.
+	Retries int
+	// RetryDelay is the delay before the first retry. It doubles with every retry.
+	RetryDelay time.Duration
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// Header adds a header to every request.
+func Header(key, value string) Option {
+	return func(cfg *Config) {
+		cfg.Header.Add(key, value)
+	}
+}
+
+// BasicAuth configures the disk to use basic authentication.
+func BasicAuth(username, password string) Option {
+	return func(cfg *Config) {
+		cfg.Username = username
+		cfg.Password = password
+	}
+}
+
+// BearerAuth configures the disk to use bearer authentication.
+func BearerAuth(token string) Option {
+	return func(cfg *Config) {
+		cfg.Token = token
+	}
+}
+
+// Writable makes Put and Delete send PUT and DELETE requests to the origin.
+func Writable() Option {
+	return func(cfg *Config) {
+		cfg.Writable = true
+	}
+}
+
+// Retry sets the number of retries of a failed request and the delay before the first retry.
+func Retry(retries int, delay time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Retries = retries
+		cfg.RetryDelay = delay
+	}
+}
+
+// NewDisk creates a new HTTP disk for the given URL template, e.g. "https://cdn.example.com/assets/{path}".
+// If client is nil, http.DefaultClient is used.
+func NewDisk(client *http.Client, urlTemplate string, options ...Option) (*Disk, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if _, err := url.Parse(strings.Replace(urlTemplate, PathPlaceholder, "", 1)); err != nil {
+		return nil, err
+	}
+
+	cfg := Config{
+		URL:        urlTemplate,
+		Header:     make(http.Header),
+		Retries:    DefaultRetries,
+		RetryDelay: DefaultRetryDelay,
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	return &Disk{
+		Client: client,
+		Config: cfg,
+	}, nil
+}
+
+// Put writes b to the file at the given path with a PUT request.
+// If the disk is not writable, it returns godrive.ErrReadOnly.
+func (d *Disk) Put(ctx context.Context, p string, b []byte) error {
+	if !d.Config.Writable {
+		return godrive.ErrReadOnly
+	}
+
+	resp, err := d.do(ctx, http.MethodPut, p, func() io.Reader { return bytes.NewReader(b) }, true)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// PutReader writes r to the file at the given path with a PUT request.
+// The request is not retried. If the disk is not writable, it returns godrive.ErrReadOnly.
+func (d *Disk) PutReader(ctx context.Context, p string, r io.Reader) error {
+	if !d.Config.Writable {
+		return godrive.ErrReadOnly
+	}
+
+	resp, err := d.do(ctx, http.MethodPut, p, func() io.Reader { return r }, false)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get retrieves the file at the given path with a GET request.
+func (d *Disk) Get(ctx context.Context, p string) ([]byte, error) {
+	r, err := d.GetReader(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// GetReader returns a reader for the body of a GET request for the file at the given path.
+func (d *Disk) GetReader(ctx context.Context, p string) (io.ReadCloser, error) {
+	resp, err := d.do(ctx, http.MethodGet, p, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Delete deletes the file at the given path with a DELETE request.
+// If the disk is not writable, it returns godrive.ErrReadOnly.
+func (d *Disk) Delete(ctx context.Context, p string) error {
+	if !d.Config.Writable {
+		return godrive.ErrReadOnly
+	}
+
+	resp, err := d.do(ctx, http.MethodDelete, p, nil, true)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// GetURL returns the URL of the file at the given path.
+func (d *Disk) GetURL(_ context.Context, p string) (string, error) {
+	return d.url(p), nil
+}
+
+// Stat returns information about the file at the given path with a HEAD request.
+// The size is -1 if the origin doesn't send a Content-Length.
+func (d *Disk) Stat(ctx context.Context, p string) (godrive.FileInfo, error) {
+	resp, err := d.do(ctx, http.MethodHead, p, nil, true)
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+	resp.Body.Close()
+
+	info := godrive.FileInfo{
+		Path:        p,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+
+	if modTime, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = modTime
+	}
+
+	return info, nil
+}
+
+// do sends a request for the file at the given path and returns the response if it has a 2xx status code.
+// body returns the request body for every attempt and may be nil. If retry is true, the request is retried
+// after network errors and 429 and 5xx responses.
+func (d *Disk) do(ctx context.Context, method, p string, body func() io.Reader, retry bool) (*http.Response, error) {
+	delay := d.Config.RetryDelay
+
+	for attempt := 0; ; attempt++ {
+		resp, err := d.send(ctx, method, p, body)
+
+		if retry && attempt < d.Config.Retries && ctx.Err() == nil && shouldRetry(resp, err) {
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			resp.Body.Close()
+			return nil, StatusError{
+				Method:     method,
+				URL:        resp.Request.URL.String(),
+				StatusCode: resp.StatusCode,
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+func (d *Disk) send(ctx context.Context, method, p string, body func() io.Reader) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		r = body()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, d.url(p), r)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, vals := range d.Config.Header {
+		req.Header[key] = vals
+	}
+
+	switch {
+	case d.Config.Token != "":
+		req.Header.Set("Authorization", "Bearer "+d.Config.Token)
+	case d.Config.Username != "":
+		req.SetBasicAuth(d.Config.Username, d.Config.Password)
+	}
+
+	return d.Client.Do(req)
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// url returns the URL of the file at the given path.
+func (d *Disk) url(p string) string {
+	segments := strings.Split(strings.TrimPrefix(path.Join("/", p), "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	escaped := strings.Join(segments, "/")
+
+	if strings.Contains(d.Config.URL, PathPlaceholder) {
+		return strings.Replace(d.Config.URL, PathPlaceholder, escaped, 1)
+	}
+
+	return strings.TrimSuffix(d.Config.URL, "/") + "/" + escaped
+}
+
+// StatusError is returned when the origin responds with a non-2xx status code.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+}
+
+func (err StatusError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", err.Method, err.URL, err.StatusCode, http.StatusText(err.StatusCode))
+}
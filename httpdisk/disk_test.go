@@ -0,0 +1,232 @@
+package httpdisk_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/httpdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+var modTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// origin is a test origin that serves files from memory.
+type origin struct {
+	mux      sync.Mutex
+	files    map[string]string
+	requests []*http.Request
+	// failures is the number of requests that fail with 503 before requests succeed.
+	failures int
+}
+
+func newOrigin(t *testing.T) (*origin, *httptest.Server) {
+	o := &origin{files: map[string]string{"assets/logo.svg": "<svg/>"}}
+	srv := httptest.NewServer(o)
+	t.Cleanup(srv.Close)
+	return o, srv
+}
+
+func (o *origin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	o.requests = append(o.requests, r)
+
+	if o.failures > 0 {
+		o.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/files/")
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		content, ok := o.files[name]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Header().Set("Last-Modified", modTime.Format(http.TimeFormat))
+		http.ServeContent(w, r, name, modTime, strings.NewReader(content))
+	case http.MethodPut:
+		b, _ := io.ReadAll(r.Body)
+		o.files[name] = string(b)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		delete(o.files, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (o *origin) lastRequest() *http.Request {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	return o.requests[len(o.requests)-1]
+}
+
+func (o *origin) requestCount() int {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	return len(o.requests)
+}
+
+func newTestDisk(t *testing.T, srv *httptest.Server, config map[string]interface{}) *httpdisk.Disk {
+	cfg := map[string]interface{}{
+		"url":        srv.URL + "/files/{path}?v=1",
+		"retryDelay": "1ms",
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := httpdisk.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return disk.(*httpdisk.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	o, srv := newOrigin(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{
+		"headers": map[string]interface{}{"X-Api-Key": "key"},
+		"token":   "token",
+	})
+	ctx := context.Background()
+
+	b, err := disk.Get(ctx, "assets/logo.svg")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("<svg/>"), b)
+	assert.Equal(t, "key", o.lastRequest().Header.Get("X-Api-Key"))
+	assert.Equal(t, "Bearer token", o.lastRequest().Header.Get("Authorization"))
+	assert.Equal(t, "v=1", o.lastRequest().URL.RawQuery)
+
+	r, err := disk.GetReader(ctx, "/assets/logo.svg")
+	assert.Nil(t, err)
+	b, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, []byte("<svg/>"), b)
+
+	info, err := disk.Stat(ctx, "assets/logo.svg")
+	assert.Nil(t, err)
+	assert.Equal(t, http.MethodHead, o.lastRequest().Method)
+	assert.Equal(t, godrive.FileInfo{
+		Path:        "assets/logo.svg",
+		Size:        int64(len("<svg/>")),
+		ContentType: "image/svg+xml",
+		ModTime:     modTime,
+	}, info)
+
+	url, err := disk.GetURL(ctx, "assets/my logo.svg")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/files/assets/my%20logo.svg?v=1", url)
+
+	_, err = disk.Get(ctx, "missing.txt")
+	assert.Equal(t, http.StatusNotFound, err.(httpdisk.StatusError).StatusCode)
+}
+
+func TestDisk_readOnly(t *testing.T) {
+	o, srv := newOrigin(t)
+	disk := newTestDisk(t, srv, nil)
+	ctx := context.Background()
+
+	assert.Equal(t, godrive.ErrReadOnly, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Equal(t, godrive.ErrReadOnly, disk.PutReader(ctx, "file.txt", strings.NewReader("content")))
+	assert.Equal(t, godrive.ErrReadOnly, disk.Delete(ctx, "assets/logo.svg"))
+	assert.Equal(t, 0, o.requestCount())
+}
+
+func TestDisk_writable(t *testing.T) {
+	_, srv := newOrigin(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{"writable": true})
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/file.txt", []byte("content")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/other.txt", strings.NewReader("other")))
+
+	b, err := disk.Get(ctx, "dir/other.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("other"), b)
+
+	assert.Nil(t, disk.Delete(ctx, "dir/file.txt"))
+	_, err = disk.Get(ctx, "dir/file.txt")
+	assert.Equal(t, http.StatusNotFound, err.(httpdisk.StatusError).StatusCode)
+}
+
+func TestDisk_retry(t *testing.T) {
+	o, srv := newOrigin(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{"retries": 2})
+	ctx := context.Background()
+
+	o.failures = 2
+	b, err := disk.Get(ctx, "assets/logo.svg")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("<svg/>"), b)
+	assert.Equal(t, 3, o.requestCount())
+
+	o.failures = 3
+	_, err = disk.Get(ctx, "assets/logo.svg")
+	assert.Equal(t, http.StatusServiceUnavailable, err.(httpdisk.StatusError).StatusCode)
+	assert.Equal(t, 6, o.requestCount())
+}
+
+func TestDisk_noRetryOnClientError(t *testing.T) {
+	o, srv := newOrigin(t)
+	disk := newTestDisk(t, srv, nil)
+
+	_, err := disk.Get(context.Background(), "missing.txt")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, o.requestCount())
+}
+
+func TestDisk_appendPath(t *testing.T) {
+	disk, err := httpdisk.NewDisk(nil, "https://cdn.example.test/assets/")
+	assert.Nil(t, err)
+
+	url, err := disk.GetURL(context.Background(), "../img/a b.png")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://cdn.example.test/assets/img/a%20b.png", url)
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := httpdisk.NewAutoWire(ctx, map[string]interface{}{})
+	assert.Equal(t, httpdisk.InvalidConfigValueError{
+		Key:     "url",
+		Details: "url must be set",
+	}, err)
+
+	_, err = httpdisk.NewAutoWire(ctx, map[string]interface{}{
+		"url":     "https://cdn.example.test",
+		"headers": map[string]interface{}{"X-Version": 2},
+	})
+	assert.Equal(t, httpdisk.InvalidConfigValueError{
+		Key:     "headers",
+		Details: "header 'X-Version' must be a string but it is 'int'",
+	}, err)
+
+	_, err = httpdisk.NewAutoWire(ctx, map[string]interface{}{"url": "https://cdn.example.test", "retryDelay": "later"})
+	assert.Equal(t, httpdisk.InvalidConfigValueError{
+		Key:     "retryDelay",
+		Details: "retry delay must be a duration but it is 'later'",
+	}, err)
+
+	disk, err := httpdisk.NewAutoWire(ctx, map[string]interface{}{"url": "https://cdn.example.test"})
+	assert.Nil(t, err)
+	assert.Equal(t, httpdisk.DefaultRetries, disk.(*httpdisk.Disk).Config.Retries)
+	assert.False(t, disk.(*httpdisk.Disk).Config.Writable)
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapURL|godrive.CapStreaming|godrive.CapStat))
+}
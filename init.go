@@ -0,0 +1,231 @@
+package godrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// InitMode determines when (*AutoWireConfig).NewManager creates the disks.
+type InitMode int
+
+const (
+	// InitEager creates the disks one after another, sorted by name, and fails on the first error (default).
+	// The disks that were already created are closed.
+	InitEager = InitMode(iota)
+	// InitParallel creates all disks concurrently and reports all errors at once.
+	InitParallel
+	// InitLazy creates each disk on first access.
+	InitLazy
+)
+
+// LazyInit makes NewManager create each disk on the first access through (*Manager).Disk()
+// (or any operation of the Manager that uses the disk) instead of on startup.
+// A disk is created at most once; if its creation fails, the error is cached and
+// returned as a DiskInitError on every access.
+// A broken configuration of a disk therefore doesn't affect the other disks.
+func LazyInit() AutoWireOption {
+	return func(cfg *AutoWireConfig) {
+		cfg.Init = InitLazy
+	}
+}
+
+// ParallelInit makes NewManager create all disks concurrently.
+// If disks cannot be created, NewManager returns the DiskInitErrors of all failed disks,
+// joined by errors.Join.
+func ParallelInit() AutoWireOption {
+	return func(cfg *AutoWireConfig) {
+		cfg.Init = InitParallel
+	}
+}
+
+// initDisks creates the disks of the configuration in the configured InitMode and configures them in m.
+func (cfg *AutoWireConfig) initDisks(ctx context.Context, m *Manager) error {
+	switch cfg.Init {
+	case InitLazy:
+		return cfg.initLazy(ctx, m)
+	case InitParallel:
+		return cfg.initParallel(ctx, m)
+	default:
+		return cfg.initEager(ctx, m)
+	}
+}
+
+func (cfg *AutoWireConfig) initEager(ctx context.Context, m *Manager) error {
+	var created []Disk
+	fail := func(err error) error {
+		for _, disk := range created {
+			closeDisk(disk)
+		}
+		return err
+	}
+
+	for _, diskname := range sortedKeys(cfg.Disks) {
+		diskcfg := cfg.Disks[diskname]
+
+		config, err := cfg.resolveDiskConfig(ctx, diskname, diskcfg)
+		if err != nil {
+			return fail(err)
+		}
+
+		disk, err := cfg.createDisk(ctx, diskcfg.Provider, config)
+		if err != nil {
+			return fail(err)
+		}
+		created = append(created, disk)
+
+		if err := m.Configure(diskname, disk, cfg.configureOptions(diskname)...); err != nil {
+			return fail(err)
+		}
+	}
+
+	return nil
+}
+
+func (cfg *AutoWireConfig) initParallel(ctx context.Context, m *Manager) error {
+	names := sortedKeys(cfg.Disks)
+	disks := make([]Disk, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	wg.Add(len(names))
+	for i, diskname := range names {
+		go func(i int, diskname string) {
+			defer wg.Done()
+
+			diskcfg := cfg.Disks[diskname]
+
+			config, err := cfg.resolveDiskConfig(ctx, diskname, diskcfg)
+			if err != nil {
+				errs[i] = DiskInitError{DiskName: diskname, Err: err}
+				return
+			}
+
+			if disks[i], err = cfg.createDisk(ctx, diskcfg.Provider, config); err != nil {
+				errs[i] = DiskInitError{DiskName: diskname, Err: err}
+			}
+		}(i, diskname)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		for _, disk := range disks {
+			if disk != nil {
+				closeDisk(disk)
+			}
+		}
+		return err
+	}
+
+	for i, diskname := range names {
+		if err := m.Configure(diskname, disks[i], cfg.configureOptions(diskname)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cfg *AutoWireConfig) initLazy(ctx context.Context, m *Manager) error {
+	// The disks are created after NewManager returned, so they must not be
+	// affected by the cancellation of ctx.
+	ctx = context.WithoutCancel(ctx)
+
+	for diskname, diskcfg := range cfg.Disks {
+		m.configureLazy(diskname, diskcfg.Provider, cfg.lazyCreate(ctx, diskname, diskcfg))
+	}
+
+	return nil
+}
+
+// lazyCreate returns a function that resolves the configuration of a disk and creates the disk.
+func (cfg *AutoWireConfig) lazyCreate(ctx context.Context, diskname string, diskcfg DiskCreatorConfig) func() (Disk, error) {
+	return func() (Disk, error) {
+		config, err := cfg.resolveDiskConfig(ctx, diskname, diskcfg)
+		if err != nil {
+			return nil, err
+		}
+		return cfg.createDisk(ctx, diskcfg.Provider, config)
+	}
+}
+
+// configureOptions returns the options for configuring a disk in the Manager.
+// The default disk is set by NewManager after all disks are configured.
+func (cfg *AutoWireConfig) configureOptions(diskname string) []ConfigureOption {
+	return []ConfigureOption{Replace(), Provider(cfg.Disks[diskname].Provider)}
+}
+
+// lazyDisk creates a Disk on first use.
+type lazyDisk struct {
+	once   sync.Once
+	create func() (Disk, error)
+	disk   Disk
+	err    error
+}
+
+func (l *lazyDisk) get() (Disk, error) {
+	l.once.Do(func() {
+		l.disk, l.err = l.create()
+	})
+	return l.disk, l.err
+}
+
+// configureLazy adds a Disk to the Manager that is created by create on first access.
+func (m *Manager) configureLazy(name, provider string, create func() (Disk, error)) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	delete(m.disks, name)
+	delete(m.wrapped, name)
+	m.pending[name] = &lazyDisk{create: create}
+	m.providers[name] = provider
+}
+
+// initPending creates the pending Disk with the given name.
+// It returns an UnconfiguredDiskError if no such Disk is pending.
+func (m *Manager) initPending(name string) (Disk, error) {
+	m.mux.RLock()
+	l, ok := m.pending[name]
+	m.mux.RUnlock()
+
+	if !ok {
+		return nil, UnconfiguredDiskError{Name: name}
+	}
+
+	disk, err := l.get()
+	if err != nil {
+		return nil, DiskInitError{DiskName: name, Err: err}
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	// The Disk may have been replaced or removed in the meantime.
+	if m.pending[name] == l {
+		delete(m.pending, name)
+		m.disks[name] = disk
+		m.wrap(name)
+	}
+
+	if disk, ok := m.wrapped[name]; ok {
+		return disk, nil
+	}
+
+	return nil, UnconfiguredDiskError{Name: name}
+}
+
+// DiskInitError means a Disk could not be created.
+type DiskInitError struct {
+	DiskName string
+	Err      error
+}
+
+func (err DiskInitError) Error() string {
+	return fmt.Sprintf("init disk '%s': %v", err.DiskName, err.Err)
+}
+
+// Unwrap returns the underlying error.
+func (err DiskInitError) Unwrap() error {
+	return err.Err
+}
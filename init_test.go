@@ -0,0 +1,158 @@
+package godrive_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+var errBrokenDisk = errors.New("broken disk")
+
+func newInitConfig(created *atomic.Int32, options ...godrive.AutoWireOption) *godrive.AutoWireConfig {
+	cfg := godrive.NewAutoWire(options...)
+	cfg.RegisterProvider("mem", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		created.Add(1)
+		return memdisk.New(), nil
+	}))
+	cfg.RegisterProvider("broken", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		created.Add(1)
+		return nil, errBrokenDisk
+	}))
+	return cfg
+}
+
+func TestEagerInit_errors(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created)
+	var disks []*closableDisk
+	cfg.RegisterProvider("closable", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		disk := &closableDisk{Disk: memdisk.New()}
+		disks = append(disks, disk)
+		return disk, nil
+	}))
+	cfg.Configure("a", "closable", nil)
+	cfg.Configure("b", "broken", nil)
+	cfg.Configure("c", "broken", nil)
+	cfg.Configure("d", "closable", nil)
+
+	// The disks are created in the order of their names.
+	_, err := cfg.NewManager(context.Background())
+	assert.Equal(t, errBrokenDisk, err)
+	assert.Equal(t, int32(1), created.Load())
+	assert.Len(t, disks, 1)
+	assert.True(t, disks[0].closed.Load())
+}
+
+func TestLazyInit(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created, godrive.LazyInit())
+	cfg.Configure("main", "mem", nil)
+	cfg.Configure("broken", "broken", nil)
+	cfg.DefaultDiskName = "main"
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), created.Load())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, m.Put(context.Background(), "file", []byte("content")))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), created.Load())
+
+	disk, err := m.Disk("main")
+	assert.Nil(t, err)
+	b, err := disk.Get(context.Background(), "file")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+
+	for i := 0; i < 2; i++ {
+		_, err = m.Disk("broken")
+
+		var initErr godrive.DiskInitError
+		assert.ErrorAs(t, err, &initErr)
+		assert.Equal(t, "broken", initErr.DiskName)
+		assert.ErrorIs(t, err, errBrokenDisk)
+	}
+	assert.Equal(t, int32(2), created.Load())
+}
+
+func TestLazyInit_configure(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created, godrive.LazyInit())
+	cfg.Configure("main", "broken", nil)
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+
+	err = m.Configure("main", memdisk.New())
+	assert.ErrorIs(t, err, godrive.DuplicateNameError{Name: "main"})
+
+	assert.Nil(t, m.Configure("main", memdisk.New(), godrive.Replace()))
+	_, err = m.Disk("main")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), created.Load())
+
+	m.RemoveDisk("main")
+	_, err = m.Disk("main")
+	assert.ErrorIs(t, err, godrive.UnconfiguredDiskError{Name: "main"})
+}
+
+func TestLazyInit_on(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created, godrive.LazyInit())
+	cfg.Configure("main", "mem", nil)
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+
+	disk := m.On("main")
+	assert.Equal(t, int32(0), created.Load())
+
+	// The interfaces of the Disk are unknown before it is created.
+	_, err = disk.(godrive.Lister).List(context.Background(), "")
+	assert.Equal(t, godrive.UnimplementedError{DiskName: "main", Interface: new(godrive.Lister)}, err)
+	assert.Equal(t, int32(1), created.Load())
+	assert.Equal(t, godrive.Capability(0), godrive.Capabilities(disk))
+}
+
+func TestParallelInit(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created, godrive.ParallelInit())
+	cfg.Configure("a", "mem", nil)
+	cfg.Configure("b", "mem", nil)
+	cfg.Configure("c", "mem", nil)
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), created.Load())
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := m.Disk(name)
+		assert.Nil(t, err)
+	}
+}
+
+func TestParallelInit_errors(t *testing.T) {
+	var created atomic.Int32
+	cfg := newInitConfig(&created, godrive.ParallelInit())
+	cfg.Configure("a", "mem", nil)
+	cfg.Configure("b", "broken", nil)
+	cfg.Configure("c", "broken", nil)
+
+	_, err := cfg.NewManager(context.Background())
+	assert.Equal(t, int32(3), created.Load())
+	assert.ErrorIs(t, err, errBrokenDisk)
+	assert.EqualError(t, err, "init disk 'b': broken disk\ninit disk 'c': broken disk")
+}
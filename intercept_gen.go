@@ -0,0 +1,4868 @@
+// Code generated by gen_intercept.go; DO NOT EDIT.
+
+package godrive
+
+// interceptedTypes returns an intercepted Disk that implements exactly the optional
+// interfaces of the capabilities it is indexed by.
+var interceptedTypes = [capAll + 1]func(*interceptedDisk) Disk{
+	0: func(d *interceptedDisk) Disk { return d },
+	1: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+		}{d, interceptedURLProvider{d}}
+	},
+	2: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+		}{d, interceptedStreamer{d}}
+	},
+	3: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}}
+	},
+	4: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+		}{d, interceptedLister{d}}
+	},
+	5: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+		}{d, interceptedURLProvider{d}, interceptedLister{d}}
+	},
+	6: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+		}{d, interceptedStreamer{d}, interceptedLister{d}}
+	},
+	7: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}}
+	},
+	8: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+		}{d, interceptedStater{d}}
+	},
+	9: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+		}{d, interceptedURLProvider{d}, interceptedStater{d}}
+	},
+	10: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+		}{d, interceptedStreamer{d}, interceptedStater{d}}
+	},
+	11: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}}
+	},
+	12: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+		}{d, interceptedLister{d}, interceptedStater{d}}
+	},
+	13: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}}
+	},
+	14: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}}
+	},
+	15: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}}
+	},
+	16: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+		}{d, interceptedSignedURLProvider{d}}
+	},
+	17: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}}
+	},
+	18: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}}
+	},
+	19: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}}
+	},
+	20: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}}
+	},
+	21: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}}
+	},
+	22: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}}
+	},
+	23: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}}
+	},
+	24: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	25: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	26: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	27: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	28: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	29: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	30: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	31: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}}
+	},
+	32: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+		}{d, interceptedCopier{d}}
+	},
+	33: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}}
+	},
+	34: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedCopier{d}}
+	},
+	35: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}}
+	},
+	36: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+		}{d, interceptedLister{d}, interceptedCopier{d}}
+	},
+	37: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}}
+	},
+	38: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}}
+	},
+	39: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}}
+	},
+	40: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedStater{d}, interceptedCopier{d}}
+	},
+	41: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	42: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	43: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	44: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	45: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	46: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	47: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}}
+	},
+	48: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	49: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	50: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	51: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	52: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	53: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	54: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	55: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	56: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	57: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	58: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	59: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	60: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	61: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	62: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	63: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}}
+	},
+	64: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedMetadataProvider
+		}{d, interceptedMetadataProvider{d}}
+	},
+	65: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	66: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedMetadataProvider{d}}
+	},
+	67: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedMetadataProvider{d}}
+	},
+	68: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedMetadataProvider{d}}
+	},
+	69: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedMetadataProvider{d}}
+	},
+	70: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}}
+	},
+	71: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}}
+	},
+	72: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	73: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	74: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	75: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	76: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	77: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	78: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	79: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}}
+	},
+	80: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	81: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	82: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	83: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	84: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	85: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	86: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	87: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	88: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	89: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	90: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	91: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	92: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	93: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	94: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	95: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}}
+	},
+	96: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	97: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	98: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	99: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	100: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	101: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	102: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	103: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	104: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	105: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	106: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	107: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	108: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	109: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	110: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	111: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	112: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	113: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	114: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	115: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	116: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	117: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	118: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	119: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	120: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	121: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	122: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	123: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	124: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	125: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	126: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	127: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}}
+	},
+	128: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedHealthChecker
+		}{d, interceptedHealthChecker{d}}
+	},
+	129: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	130: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedHealthChecker{d}}
+	},
+	131: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedHealthChecker{d}}
+	},
+	132: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedHealthChecker{d}}
+	},
+	133: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedHealthChecker{d}}
+	},
+	134: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedHealthChecker{d}}
+	},
+	135: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedHealthChecker{d}}
+	},
+	136: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	137: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	138: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	139: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	140: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	141: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	142: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	143: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}}
+	},
+	144: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	145: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	146: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	147: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	148: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	149: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	150: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	151: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	152: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	153: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	154: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	155: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	156: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	157: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	158: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	159: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}}
+	},
+	160: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	161: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	162: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	163: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	164: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	165: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	166: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	167: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	168: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	169: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	170: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	171: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	172: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	173: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	174: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	175: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	176: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	177: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	178: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	179: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	180: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	181: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	182: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	183: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	184: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	185: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	186: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	187: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	188: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	189: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	190: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	191: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}}
+	},
+	192: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	193: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	194: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	195: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	196: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	197: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	198: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	199: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	200: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	201: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	202: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	203: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	204: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	205: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	206: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	207: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	208: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	209: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	210: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	211: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	212: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	213: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	214: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	215: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	216: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	217: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	218: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	219: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	220: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	221: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	222: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	223: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	224: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	225: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	226: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	227: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	228: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	229: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	230: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	231: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	232: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	233: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	234: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	235: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	236: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	237: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	238: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	239: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	240: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	241: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	242: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	243: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	244: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	245: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	246: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	247: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	248: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	249: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	250: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	251: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	252: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	253: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	254: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	255: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}}
+	},
+	256: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedVersioner
+		}{d, interceptedVersioner{d}}
+	},
+	257: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedVersioner{d}}
+	},
+	258: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedVersioner{d}}
+	},
+	259: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedVersioner{d}}
+	},
+	260: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedVersioner{d}}
+	},
+	261: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedVersioner{d}}
+	},
+	262: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedVersioner{d}}
+	},
+	263: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedVersioner{d}}
+	},
+	264: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	265: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	266: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	267: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	268: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	269: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	270: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	271: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedVersioner{d}}
+	},
+	272: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	273: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	274: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	275: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	276: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	277: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	278: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	279: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	280: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	281: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	282: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	283: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	284: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	285: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	286: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	287: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedVersioner{d}}
+	},
+	288: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	289: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	290: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	291: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	292: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	293: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	294: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	295: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	296: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	297: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	298: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	299: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	300: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	301: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	302: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	303: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	304: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	305: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	306: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	307: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	308: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	309: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	310: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	311: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	312: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	313: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	314: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	315: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	316: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	317: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	318: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	319: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedVersioner{d}}
+	},
+	320: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	321: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	322: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	323: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	324: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	325: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	326: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	327: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	328: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	329: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	330: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	331: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	332: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	333: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	334: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	335: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	336: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	337: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	338: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	339: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	340: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	341: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	342: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	343: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	344: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	345: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	346: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	347: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	348: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	349: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	350: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	351: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	352: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	353: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	354: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	355: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	356: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	357: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	358: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	359: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	360: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	361: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	362: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	363: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	364: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	365: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	366: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	367: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	368: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	369: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	370: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	371: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	372: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	373: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	374: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	375: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	376: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	377: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	378: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	379: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	380: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	381: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	382: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	383: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedVersioner{d}}
+	},
+	384: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	385: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	386: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	387: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	388: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	389: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	390: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	391: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	392: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	393: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	394: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	395: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	396: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	397: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	398: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	399: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	400: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	401: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	402: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	403: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	404: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	405: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	406: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	407: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	408: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	409: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	410: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	411: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	412: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	413: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	414: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	415: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	416: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	417: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	418: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	419: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	420: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	421: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	422: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	423: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	424: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	425: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	426: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	427: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	428: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	429: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	430: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	431: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	432: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	433: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	434: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	435: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	436: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	437: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	438: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	439: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	440: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	441: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	442: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	443: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	444: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	445: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	446: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	447: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	448: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	449: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	450: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	451: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	452: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	453: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	454: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	455: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	456: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	457: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	458: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	459: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	460: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	461: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	462: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	463: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	464: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	465: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	466: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	467: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	468: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	469: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	470: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	471: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	472: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	473: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	474: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	475: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	476: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	477: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	478: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	479: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	480: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	481: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	482: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	483: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	484: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	485: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	486: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	487: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	488: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	489: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	490: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	491: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	492: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	493: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	494: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	495: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	496: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	497: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	498: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	499: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	500: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	501: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	502: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	503: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	504: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	505: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	506: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	507: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	508: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	509: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	510: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+	511: func(d *interceptedDisk) Disk {
+		return struct {
+			*interceptedDisk
+			interceptedURLProvider
+			interceptedStreamer
+			interceptedLister
+			interceptedStater
+			interceptedSignedURLProvider
+			interceptedCopier
+			interceptedMetadataProvider
+			interceptedHealthChecker
+			interceptedVersioner
+		}{d, interceptedURLProvider{d}, interceptedStreamer{d}, interceptedLister{d}, interceptedStater{d}, interceptedSignedURLProvider{d}, interceptedCopier{d}, interceptedMetadataProvider{d}, interceptedHealthChecker{d}, interceptedVersioner{d}}
+	},
+}
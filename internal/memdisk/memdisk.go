@@ -0,0 +1,95 @@
+// Package memdisk provides in-memory disks for the tests of godrive and its packages.
+package memdisk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bounoable/godrive"
+)
+
+// Disk stores files in memory. It implements no optional interfaces; use Lister for a
+// Disk that can list files.
+type Disk struct {
+	mux   sync.RWMutex
+	files map[string][]byte
+	puts  int
+}
+
+// New returns an empty Disk.
+func New() *Disk {
+	return &Disk{files: make(map[string][]byte)}
+}
+
+// Put stores a copy of b.
+func (d *Disk) Put(_ context.Context, path string, b []byte) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.files[path] = append([]byte(nil), b...)
+	d.puts++
+	return nil
+}
+
+// Get returns a copy of the file at the given path.
+func (d *Disk) Get(_ context.Context, path string) ([]byte, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	b, ok := d.files[path]
+	if !ok {
+		return nil, fmt.Errorf("file not found: %s", path)
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(_ context.Context, path string) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	delete(d.files, path)
+	return nil
+}
+
+// Puts returns the number of calls to Put.
+func (d *Disk) Puts() int {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	return d.puts
+}
+
+// Lister is a Disk that also implements godrive.Lister and godrive.Stater.
+type Lister struct {
+	*Disk
+}
+
+// NewLister returns an empty Lister.
+func NewLister() Lister {
+	return Lister{New()}
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (d Lister) List(_ context.Context, prefix string) ([]string, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	var paths []string
+	for path := range d.files {
+		if strings.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Stat returns the size of the file at the given path.
+func (d Lister) Stat(_ context.Context, path string) (godrive.FileInfo, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	b, ok := d.files[path]
+	if !ok {
+		return godrive.FileInfo{}, fmt.Errorf("file not found: %s", path)
+	}
+	return godrive.FileInfo{Path: path, Size: int64(len(b))}, nil
+}
@@ -0,0 +1,213 @@
+package godrive
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+)
+
+// LogOption is an option for the logging decorator.
+type LogOption func(*logConfig)
+
+type logConfig struct {
+	diskName   string
+	level      slog.Level
+	errorLevel slog.Level
+	redact     func(string) string
+}
+
+// LogDiskName sets the disk name that is added to every log record.
+func LogDiskName(name string) LogOption {
+	return func(cfg *logConfig) {
+		cfg.diskName = name
+	}
+}
+
+// LogLevel sets the level for successful operations. Defaults to slog.LevelDebug.
+func LogLevel(level slog.Level) LogOption {
+	return func(cfg *logConfig) {
+		cfg.level = level
+	}
+}
+
+// LogErrorLevel sets the level for failed operations. Defaults to slog.LevelError.
+func LogErrorLevel(level slog.Level) LogOption {
+	return func(cfg *logConfig) {
+		cfg.errorLevel = level
+	}
+}
+
+// RedactPath sets a function that is applied to every path before it is logged.
+// Use it to remove personally identifiable information from the logs.
+func RedactPath(fn func(path string) string) LogOption {
+	return func(cfg *logConfig) {
+		cfg.redact = fn
+	}
+}
+
+// WithLogger wraps disk so that every operation is logged to logger.
+// Each record contains the disk name, the operation, the (redacted) path,
+// the size of the file in bytes, the duration and the error if the operation failed.
+func WithLogger(disk Disk, logger *slog.Logger, options ...LogOption) Disk {
+	cfg := logConfig{
+		level:      slog.LevelDebug,
+		errorLevel: slog.LevelError,
+	}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	l := &diskLogger{logger: logger, cfg: cfg}
+
+	return Intercept(Interceptor{
+		Put: func(ctx context.Context, path string, b []byte, next PutFunc) error {
+			start := time.Now()
+			err := next(ctx, path, b)
+			l.log(ctx, "put", path, len(b), start, err)
+			return err
+		},
+		Get: func(ctx context.Context, path string, next GetFunc) ([]byte, error) {
+			start := time.Now()
+			b, err := next(ctx, path)
+			l.log(ctx, "get", path, len(b), start, err)
+			return b, err
+		},
+		Delete: func(ctx context.Context, path string, next DeleteFunc) error {
+			start := time.Now()
+			err := next(ctx, path)
+			l.log(ctx, "delete", path, -1, start, err)
+			return err
+		},
+		GetURL: func(ctx context.Context, path string, next GetURLFunc) (string, error) {
+			start := time.Now()
+			url, err := next(ctx, path)
+			l.log(ctx, "get_url", path, -1, start, err)
+			return url, err
+		},
+		PutReader: func(ctx context.Context, path string, r io.Reader, next PutReaderFunc) error {
+			start := time.Now()
+			err := next(ctx, path, r)
+			l.log(ctx, "put_reader", path, -1, start, err)
+			return err
+		},
+		GetReader: func(ctx context.Context, path string, next GetReaderFunc) (io.ReadCloser, error) {
+			start := time.Now()
+			r, err := next(ctx, path)
+			l.log(ctx, "get_reader", path, -1, start, err)
+			return r, err
+		},
+		List: func(ctx context.Context, prefix string, next ListFunc) ([]string, error) {
+			start := time.Now()
+			paths, err := next(ctx, prefix)
+			l.log(ctx, "list", prefix, -1, start, err)
+			return paths, err
+		},
+		Stat: func(ctx context.Context, path string, next StatFunc) (FileInfo, error) {
+			start := time.Now()
+			info, err := next(ctx, path)
+			size := int(info.Size)
+			if err != nil {
+				size = -1
+			}
+			l.log(ctx, "stat", path, size, start, err)
+			return info, err
+		},
+		GetSignedURL: func(ctx context.Context, path string, expiry time.Duration, next GetSignedURLFunc) (string, error) {
+			start := time.Now()
+			url, err := next(ctx, path, expiry)
+			l.log(ctx, "get_signed_url", path, -1, start, err)
+			return url, err
+		},
+		Copy: func(ctx context.Context, src, dst string, next CopyFunc) error {
+			start := time.Now()
+			err := next(ctx, src, dst)
+			l.log(ctx, "copy", src, -1, start, err, slog.String("dst", l.redact(dst)))
+			return err
+		},
+		GetMetadata: func(ctx context.Context, path string, next GetMetadataFunc) (map[string]string, error) {
+			start := time.Now()
+			md, err := next(ctx, path)
+			l.log(ctx, "get_metadata", path, -1, start, err)
+			return md, err
+		},
+		SetMetadata: func(ctx context.Context, path string, metadata map[string]string, next SetMetadataFunc) error {
+			start := time.Now()
+			err := next(ctx, path, metadata)
+			l.log(ctx, "set_metadata", path, -1, start, err)
+			return err
+		},
+		CheckHealth: func(ctx context.Context, next CheckHealthFunc) error {
+			start := time.Now()
+			err := next(ctx)
+			l.log(ctx, "check_health", "", -1, start, err)
+			return err
+		},
+		ListVersions: func(ctx context.Context, path string, next ListVersionsFunc) ([]Version, error) {
+			start := time.Now()
+			versions, err := next(ctx, path)
+			l.log(ctx, "list_versions", path, -1, start, err)
+			return versions, err
+		},
+		GetVersion: func(ctx context.Context, path, versionID string, next GetVersionFunc) ([]byte, error) {
+			start := time.Now()
+			b, err := next(ctx, path, versionID)
+			l.log(ctx, "get_version", path, len(b), start, err, slog.String("version", versionID))
+			return b, err
+		},
+		RestoreVersion: func(ctx context.Context, path, versionID string, next RestoreVersionFunc) error {
+			start := time.Now()
+			err := next(ctx, path, versionID)
+			l.log(ctx, "restore_version", path, -1, start, err, slog.String("version", versionID))
+			return err
+		},
+		DeleteVersion: func(ctx context.Context, path, versionID string, next DeleteVersionFunc) error {
+			start := time.Now()
+			err := next(ctx, path, versionID)
+			l.log(ctx, "delete_version", path, -1, start, err, slog.String("version", versionID))
+			return err
+		},
+	})(disk)
+}
+
+type diskLogger struct {
+	logger *slog.Logger
+	cfg    logConfig
+}
+
+func (l *diskLogger) redact(path string) string {
+	if l.cfg.redact == nil {
+		return path
+	}
+	return l.cfg.redact(path)
+}
+
+func (l *diskLogger) log(ctx context.Context, op, path string, size int, start time.Time, err error, extra ...slog.Attr) {
+	level := l.cfg.level
+	if err != nil {
+		level = l.cfg.errorLevel
+	}
+
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("disk", l.cfg.diskName),
+		slog.String("op", op),
+		slog.String("path", l.redact(path)),
+	}
+	attrs = append(attrs, extra...)
+	if size >= 0 {
+		attrs = append(attrs, slog.Int("size", size))
+	}
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	msg := "godrive: " + op
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		msg += " failed"
+	}
+
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
@@ -0,0 +1,125 @@
+package godrive_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	disk := godrive.WithLogger(
+		memdisk.New(),
+		logger,
+		godrive.LogDiskName("main"),
+		godrive.RedactPath(func(path string) string {
+			return strings.Replace(path, "alice", "***", 1)
+		}),
+	)
+
+	err := disk.Put(context.Background(), "users/alice/avatar.png", []byte("hello"))
+	assert.Nil(t, err)
+
+	var record map[string]interface{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "DEBUG", record["level"])
+	assert.Equal(t, "main", record["disk"])
+	assert.Equal(t, "put", record["op"])
+	assert.Equal(t, "users/***/avatar.png", record["path"])
+	assert.Equal(t, float64(5), record["size"])
+	assert.Contains(t, record, "duration")
+	assert.NotContains(t, record, "error")
+
+	buf.Reset()
+	_, err = disk.Get(context.Background(), "missing.txt")
+	assert.NotNil(t, err)
+
+	record = nil
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "get", record["op"])
+	assert.Equal(t, err.Error(), record["error"])
+}
+
+func TestWithLogger_getURL(t *testing.T) {
+	disk := godrive.WithLogger(memdisk.New(), slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+
+	_, ok := disk.(godrive.URLProvider)
+	assert.False(t, ok)
+
+	disk = godrive.WithLogger(urlDisk{memdisk.New()}, slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+	url, err := disk.(godrive.URLProvider).GetURL(context.Background(), "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.test/file.txt", url)
+}
+
+func TestWithLogger_checkHealth(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	disk := godrive.WithLogger(healthDisk{Disk: memdisk.New(), err: errors.New("bucket not found")}, logger)
+	assert.NotNil(t, disk.(godrive.HealthChecker).CheckHealth(context.Background()))
+
+	var record map[string]interface{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "check_health", record["op"])
+	assert.Equal(t, "bucket not found", record["error"])
+}
+
+func TestWithLogger_versions(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := context.Background()
+
+	disk := godrive.WithLogger(godrive.WithVersions(memdisk.NewLister()), logger)
+	vdisk := disk.(godrive.Versioner)
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+
+	versions, err := vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	_, err = vdisk.GetVersion(ctx, "file.txt", versions[0].ID)
+	assert.Nil(t, err)
+	assert.Nil(t, vdisk.RestoreVersion(ctx, "file.txt", versions[0].ID))
+	assert.Nil(t, vdisk.DeleteVersion(ctx, "file.txt", versions[0].ID))
+
+	var ops []interface{}
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var record map[string]interface{}
+		assert.Nil(t, dec.Decode(&record))
+		assert.Equal(t, "file.txt", record["path"])
+		if record["op"] != "put" && record["op"] != "list_versions" {
+			assert.Equal(t, versions[0].ID, record["version"])
+		}
+		ops = append(ops, record["op"])
+	}
+	assert.Equal(t, []interface{}{"put", "list_versions", "get_version", "restore_version", "delete_version"}, ops)
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	m := godrive.New(godrive.Logger(logger, godrive.LogLevel(slog.LevelInfo)))
+	m.Configure("main", memdisk.New())
+
+	err := m.Put(context.Background(), "file.txt", []byte("hello"))
+	assert.Nil(t, err)
+
+	var record map[string]interface{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "main", record["disk"])
+	assert.Equal(t, "file.txt", record["path"])
+}
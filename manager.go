@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 )
 
@@ -18,6 +19,10 @@ var (
 type Manager struct {
 	mux         sync.RWMutex
 	disks       map[string]Disk
+	wrapped     map[string]Disk
+	pending     map[string]*lazyDisk
+	providers   map[string]string
+	middleware  []scopedMiddleware
 	defaultDisk string
 }
 
@@ -25,9 +30,33 @@ type Manager struct {
 // and also implements the Disk interface, so it can be directly used to access the configured default disk.
 //
 // Normally you don't instantiate the manager with New() but through the AutoWire config.
-func New() *Manager {
-	return &Manager{
-		disks: make(map[string]Disk),
+func New(options ...ManagerOption) *Manager {
+	m := &Manager{
+		disks:     make(map[string]Disk),
+		wrapped:   make(map[string]Disk),
+		pending:   make(map[string]*lazyDisk),
+		providers: make(map[string]string),
+	}
+
+	for _, opt := range options {
+		opt(m)
+	}
+
+	return m
+}
+
+// ManagerOption is a Manager option.
+type ManagerOption func(*Manager)
+
+// Logger makes the Manager log every operation of its Disks to logger.
+// Each Disk is wrapped with WithLogger when it is configured, the name of the Disk is added automatically.
+func Logger(logger *slog.Logger, options ...LogOption) ManagerOption {
+	return func(m *Manager) {
+		m.middleware = append(m.middleware, scopedMiddleware{
+			apply: func(name string, next Disk) Disk {
+				return WithLogger(next, logger, append([]LogOption{LogDiskName(name)}, options...)...)
+			},
+		})
 	}
 }
 
@@ -37,6 +66,7 @@ type ConfigureOption func(*configureConfig)
 type configureConfig struct {
 	replace   bool
 	asDefault bool
+	provider  string
 }
 
 // Replace will replace the previously configured Disk with the same name.
@@ -53,6 +83,13 @@ func Default() ConfigureOption {
 	}
 }
 
+// Provider sets the name of the storage provider of the Disk, which is reported by (*Manager).Disks().
+func Provider(name string) ConfigureOption {
+	return func(cfg *configureConfig) {
+		cfg.provider = name
+	}
+}
+
 // Configure adds a Disk to the Manager.
 // If the name is already in use, it returns a DuplicateNameError unless the Replace option is used.
 // The first Disk will automatically be made the default Disk, even if the Default option is not used.
@@ -66,22 +103,65 @@ func (m *Manager) Configure(name string, disk Disk, options ...ConfigureOption)
 	defer m.mux.Unlock()
 
 	defer func() {
-		if cfg.asDefault || len(m.disks) == 1 {
+		if cfg.asDefault || len(m.disks)+len(m.pending) == 1 {
 			m.defaultDisk = name
 		}
 	}()
 
-	if _, ok := m.disks[name]; ok {
+	if m.configured(name) {
 		if !cfg.replace {
 			return DuplicateNameError{Name: name}
 		}
 	}
 
+	delete(m.pending, name)
 	m.disks[name] = disk
+	m.providers[name] = cfg.provider
+	m.wrap(name)
 
 	return nil
 }
 
+// Use applies middleware to all Disks of the Manager, including Disks that are configured later.
+// Middleware is applied in the order it was added, so the first middleware is the outermost.
+func (m *Manager) Use(middleware ...Middleware) {
+	m.use(nil, middleware)
+}
+
+// UseFor applies middleware to the Disks with the given names.
+func (m *Manager) UseFor(disknames []string, middleware ...Middleware) {
+	m.use(disknames, middleware)
+}
+
+func (m *Manager) use(disknames []string, middleware []Middleware) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	for _, mw := range middleware {
+		mw := mw
+		m.middleware = append(m.middleware, scopedMiddleware{
+			disks: disknames,
+			apply: func(_ string, next Disk) Disk { return mw(next) },
+		})
+	}
+
+	for name := range m.disks {
+		m.wrap(name)
+	}
+}
+
+// wrap applies the middleware to the Disk with the given name.
+// m.mux must be locked by the caller.
+func (m *Manager) wrap(name string) {
+	disk := m.disks[name]
+	for i := len(m.middleware) - 1; i >= 0; i-- {
+		if mw := m.middleware[i]; mw.appliesTo(name) {
+			disk = mw.apply(name, disk)
+		}
+	}
+	m.wrapped[name] = disk
+}
+
 // DuplicateNameError is returned when a Disk is added to a Manager with a name that was already used.
 type DuplicateNameError struct {
 	Name string
@@ -96,21 +176,136 @@ func (m *Manager) RemoveDisk(name string) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 	delete(m.disks, name)
+	delete(m.wrapped, name)
+	delete(m.pending, name)
+	delete(m.providers, name)
 }
 
-// Disk returns the Disk with the configured name.
+// Disk returns the Disk with the configured name, decorated with the middleware of the Manager.
 // If no Disk with the name is configured, it returns an UnconfiguredDiskError.
+// If the Disk is initialized lazily (see LazyInit) and cannot be created, it returns a DiskInitError.
 func (m *Manager) Disk(name string) (Disk, error) {
 	m.mux.RLock()
-	defer m.mux.RUnlock()
-	disk, ok := m.disks[name]
+	disk, ok := m.wrapped[name]
+	m.mux.RUnlock()
 	if !ok {
-		return nil, UnconfiguredDiskError{Name: name}
+		return m.initPending(name)
 	}
 
 	return disk, nil
 }
 
+// Capabilities returns the capabilities of the Disk with the configured name.
+// If no Disk with the name is configured, it returns an UnconfiguredDiskError.
+func (m *Manager) Capabilities(name string) (Capability, error) {
+	disk, err := m.Disk(name)
+	if err != nil {
+		return 0, err
+	}
+	return Capabilities(disk), nil
+}
+
+// Require returns a MissingCapabilitiesError if the Disk with the configured name
+// does not support all of the required capabilities.
+// Use it to validate at startup that a configured Disk fits the needs of the application.
+func (m *Manager) Require(name string, required Capability) error {
+	disk, err := m.Disk(name)
+	if err != nil {
+		return err
+	}
+
+	if err := Require(disk, required); err != nil {
+		missing := err.(MissingCapabilitiesError)
+		missing.DiskName = name
+		return missing
+	}
+
+	return nil
+}
+
+// DefaultDisk returns the default Disk, decorated with the middleware of the Manager.
+// If no default Disk is set, it returns ErrNoDefaultDisk.
+func (m *Manager) DefaultDisk() (Disk, error) {
+	disk, _, err := m.defaultDiskWithName()
+	return disk, err
+}
+
+func (m *Manager) defaultDiskWithName() (Disk, string, error) {
+	m.mux.RLock()
+	name := m.defaultDisk
+	m.mux.RUnlock()
+
+	if name == "" {
+		return nil, "", ErrNoDefaultDisk
+	}
+
+	disk, err := m.Disk(name)
+	if err != nil {
+		if errors.As(err, &UnconfiguredDiskError{}) {
+			return nil, "", ErrNoDefaultDisk
+		}
+
+		return nil, "", err
+	}
+
+	return disk, name, nil
+}
+
+// SetDefault makes the Disk with the given name the default Disk.
+// If no Disk with the name is configured, it returns an UnconfiguredDiskError.
+// An empty name unsets the default Disk.
+func (m *Manager) SetDefault(name string) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if name != "" && !m.configured(name) {
+		return UnconfiguredDiskError{Name: name}
+	}
+
+	m.defaultDisk = name
+
+	return nil
+}
+
+// configured determines if a Disk with the given name is configured.
+// m.mux must be locked by the caller.
+func (m *Manager) configured(name string) bool {
+	_, ok := m.disks[name]
+	if !ok {
+		_, ok = m.pending[name]
+	}
+	return ok
+}
+
+// DiskInfo describes a Disk of a Manager.
+type DiskInfo struct {
+	Name string
+	// Provider is the name of the storage provider (see Provider), or empty if unknown.
+	Provider string
+	// Default is true for the default Disk.
+	Default bool
+}
+
+// Disks returns the configured Disks, sorted by name.
+// Lazily initialized Disks (see LazyInit) are included but not created.
+func (m *Manager) Disks() []DiskInfo {
+	names := m.names()
+
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	infos := make([]DiskInfo, 0, len(names))
+	for _, name := range names {
+		infos = append(infos, DiskInfo{
+			Name:     name,
+			Provider: m.providers[name],
+			Default:  name == m.defaultDisk,
+		})
+	}
+
+	return infos
+}
+
 // UnconfiguredDiskError is returned when no Disk can be found for a name.
 type UnconfiguredDiskError struct {
 	Name string
@@ -121,14 +316,11 @@ func (err UnconfiguredDiskError) Error() string {
 }
 
 // Put writes b to the file at the given path on the default Disk.
+// The path may also be a disk URI (see ParseURI) to write to another Disk.
 // If no default Disk is set, it returns ErrNoDefaultDisk.
 func (m *Manager) Put(ctx context.Context, path string, b []byte) error {
-	disk, err := m.Disk(m.defaultDisk)
+	disk, _, path, err := m.resolve(path)
 	if err != nil {
-		if errors.As(err, &UnconfiguredDiskError{}) {
-			return ErrNoDefaultDisk
-		}
-
 		return err
 	}
 
@@ -136,14 +328,11 @@ func (m *Manager) Put(ctx context.Context, path string, b []byte) error {
 }
 
 // Get retrieves the file at the given path.
+// The path may also be a disk URI (see ParseURI) to read from another Disk.
 // If no default Disk is set, it returns ErrNoDefaultDisk.
 func (m *Manager) Get(ctx context.Context, path string) ([]byte, error) {
-	disk, err := m.Disk(m.defaultDisk)
+	disk, _, path, err := m.resolve(path)
 	if err != nil {
-		if errors.As(err, &UnconfiguredDiskError{}) {
-			return nil, ErrNoDefaultDisk
-		}
-
 		return nil, err
 	}
 
@@ -151,14 +340,11 @@ func (m *Manager) Get(ctx context.Context, path string) ([]byte, error) {
 }
 
 // Delete deletes the file at the given path.
+// The path may also be a disk URI (see ParseURI) to delete from another Disk.
 // If no default Disk is set, it returns ErrNoDefaultDisk.
 func (m *Manager) Delete(ctx context.Context, path string) error {
-	disk, err := m.Disk(m.defaultDisk)
+	disk, _, path, err := m.resolve(path)
 	if err != nil {
-		if errors.As(err, &UnconfiguredDiskError{}) {
-			return ErrNoDefaultDisk
-		}
-
 		return err
 	}
 
@@ -166,27 +352,61 @@ func (m *Manager) Delete(ctx context.Context, path string) error {
 }
 
 // GetURL returns the public URL for the file at the given path.
+// The path may also be a disk URI (see ParseURI) to address another Disk.
 // If no default Disk is set, it returns ErrNoDefaultDisk.
-// If the default Disk does not implement URLProvider, it returns an UnimplementedError.
+// If the Disk does not implement URLProvider, it returns an UnimplementedError.
 func (m *Manager) GetURL(ctx context.Context, path string) (string, error) {
-	disk, err := m.Disk(m.defaultDisk)
+	disk, name, path, err := m.resolve(path)
 	if err != nil {
-		if errors.As(err, &UnconfiguredDiskError{}) {
-			return "", ErrNoDefaultDisk
-		}
-
 		return "", err
 	}
 
 	urldisk, ok := disk.(URLProvider)
 	if !ok {
 		return "", UnimplementedError{
-			DiskName:  m.defaultDisk,
+			DiskName:  name,
 			Interface: new(URLProvider),
 		}
 	}
 
-	return urldisk.GetURL(ctx, path)
+	url, err := urldisk.GetURL(ctx, path)
+
+	return url, withDiskName(err, name)
+}
+
+// resolve returns the Disk and the path on that Disk for a path that was passed to an operation of the Manager.
+// If path is a disk URI, the Disk with the name from the URI is returned, otherwise the default Disk.
+func (m *Manager) resolve(path string) (Disk, string, string, error) {
+	if !IsURI(path) {
+		disk, name, err := m.defaultDiskWithName()
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		return disk, name, path, nil
+	}
+
+	name, path, err := ParseURI(path)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	disk, err := m.Disk(name)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return disk, name, path, nil
+}
+
+// withDiskName adds the disk name to an UnimplementedError that was returned by a Disk decorator.
+func withDiskName(err error, name string) error {
+	var unimplemented UnimplementedError
+	if errors.As(err, &unimplemented) && unimplemented.DiskName == "" {
+		unimplemented.DiskName = name
+		return unimplemented
+	}
+	return err
 }
 
 // UnimplementedError means a Disk does not implement a specific feature.
@@ -0,0 +1,103 @@
+package godrive_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDefaultConfig(disknames ...string) *godrive.AutoWireConfig {
+	cfg := godrive.NewAutoWire()
+	cfg.RegisterProvider("mem", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		return memdisk.New(), nil
+	}))
+	for _, name := range disknames {
+		cfg.Configure(name, "mem", nil)
+	}
+	return cfg
+}
+
+func TestNewManager_defaultDisk(t *testing.T) {
+	for _, options := range [][]godrive.AutoWireOption{nil, {godrive.LazyInit()}, {godrive.ParallelInit()}} {
+		cfg := newDefaultConfig("a", "b", "c")
+		for _, opt := range options {
+			opt(cfg)
+		}
+		cfg.DefaultDiskName = "b"
+
+		m, err := cfg.NewManager(context.Background())
+		assert.Nil(t, err)
+
+		b, _ := m.Disk("b")
+		disk, err := m.DefaultDisk()
+		assert.Nil(t, err)
+		assert.Same(t, b, disk)
+	}
+}
+
+func TestNewManager_singleDiskIsDefault(t *testing.T) {
+	m, err := newDefaultConfig("main").NewManager(context.Background())
+	assert.Nil(t, err)
+
+	main, _ := m.Disk("main")
+	disk, err := m.DefaultDisk()
+	assert.Nil(t, err)
+	assert.Same(t, main, disk)
+}
+
+func TestNewManager_ambiguousDefaultDisk(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		m, err := newDefaultConfig("a", "b", "c").NewManager(context.Background())
+		assert.Nil(t, err)
+
+		_, err = m.DefaultDisk()
+		assert.ErrorIs(t, err, godrive.ErrNoDefaultDisk)
+		assert.ErrorIs(t, m.Put(context.Background(), "file", nil), godrive.ErrNoDefaultDisk)
+		assert.Nil(t, m.Put(context.Background(), godrive.URI("a", "file"), nil))
+	}
+}
+
+func TestNewManager_unknownDefaultDisk(t *testing.T) {
+	cfg := newDefaultConfig("a", "b")
+	cfg.DefaultDiskName = "c"
+
+	_, err := cfg.NewManager(context.Background())
+	assert.ErrorIs(t, err, godrive.UnknownDefaultDiskError{Name: "c"})
+}
+
+func TestManager_SetDefault(t *testing.T) {
+	m := godrive.New()
+	m.Configure("a", memdisk.New())
+	m.Configure("b", memdisk.New())
+
+	assert.ErrorIs(t, m.SetDefault("c"), godrive.UnconfiguredDiskError{Name: "c"})
+
+	assert.Nil(t, m.SetDefault("b"))
+	b, _ := m.Disk("b")
+	disk, err := m.DefaultDisk()
+	assert.Nil(t, err)
+	assert.Same(t, b, disk)
+
+	assert.Nil(t, m.SetDefault(""))
+	_, err = m.DefaultDisk()
+	assert.ErrorIs(t, err, godrive.ErrNoDefaultDisk)
+}
+
+func TestManager_Disks(t *testing.T) {
+	cfg := newDefaultConfig("b", "a")
+	cfg.Init = godrive.InitLazy
+	cfg.DefaultDiskName = "b"
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	m.Configure("c", memdisk.New(), godrive.Provider("custom"))
+
+	assert.Equal(t, []godrive.DiskInfo{
+		{Name: "a", Provider: "mem"},
+		{Name: "b", Provider: "mem", Default: true},
+		{Name: "c", Provider: "custom"},
+	}, m.Disks())
+}
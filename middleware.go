@@ -0,0 +1,535 @@
+package godrive
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Middleware decorates a Disk.
+type Middleware func(next Disk) Disk
+
+// Wrap decorates disk with the given middleware.
+// The first middleware is the outermost, so it is called first on every operation.
+func Wrap(disk Disk, middleware ...Middleware) Disk {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		disk = middleware[i](disk)
+	}
+	return disk
+}
+
+// PutFunc is the signature of (Disk).Put().
+type PutFunc func(ctx context.Context, path string, b []byte) error
+
+// GetFunc is the signature of (Disk).Get().
+type GetFunc func(ctx context.Context, path string) ([]byte, error)
+
+// DeleteFunc is the signature of (Disk).Delete().
+type DeleteFunc func(ctx context.Context, path string) error
+
+// GetURLFunc is the signature of (URLProvider).GetURL().
+type GetURLFunc func(ctx context.Context, path string) (string, error)
+
+// PutReaderFunc is the signature of (Streamer).PutReader().
+type PutReaderFunc func(ctx context.Context, path string, r io.Reader) error
+
+// GetReaderFunc is the signature of (Streamer).GetReader().
+type GetReaderFunc func(ctx context.Context, path string) (io.ReadCloser, error)
+
+// ListFunc is the signature of (Lister).List().
+type ListFunc func(ctx context.Context, prefix string) ([]string, error)
+
+// StatFunc is the signature of (Stater).Stat().
+type StatFunc func(ctx context.Context, path string) (FileInfo, error)
+
+// GetSignedURLFunc is the signature of (SignedURLProvider).GetSignedURL().
+type GetSignedURLFunc func(ctx context.Context, path string, expiry time.Duration) (string, error)
+
+// CopyFunc is the signature of (Copier).Copy().
+type CopyFunc func(ctx context.Context, src, dst string) error
+
+// GetMetadataFunc is the signature of (MetadataProvider).GetMetadata().
+type GetMetadataFunc func(ctx context.Context, path string) (map[string]string, error)
+
+// SetMetadataFunc is the signature of (MetadataProvider).SetMetadata().
+type SetMetadataFunc func(ctx context.Context, path string, metadata map[string]string) error
+
+// CheckHealthFunc is the signature of (HealthChecker).CheckHealth().
+type CheckHealthFunc func(ctx context.Context) error
+
+// ListVersionsFunc is the signature of (Versioner).ListVersions().
+type ListVersionsFunc func(ctx context.Context, path string) ([]Version, error)
+
+// GetVersionFunc is the signature of (Versioner).GetVersion().
+type GetVersionFunc func(ctx context.Context, path, versionID string) ([]byte, error)
+
+// RestoreVersionFunc is the signature of (Versioner).RestoreVersion().
+type RestoreVersionFunc func(ctx context.Context, path, versionID string) error
+
+// DeleteVersionFunc is the signature of (Versioner).DeleteVersion().
+type DeleteVersionFunc func(ctx context.Context, path, versionID string) error
+
+// Interceptor intercepts single Disk operations.
+// Each interceptor receives the next function in the chain and decides if and how to call it.
+// Operations without an interceptor are passed through to the wrapped Disk.
+type Interceptor struct {
+	Put    func(ctx context.Context, path string, b []byte, next PutFunc) error
+	Get    func(ctx context.Context, path string, next GetFunc) ([]byte, error)
+	Delete func(ctx context.Context, path string, next DeleteFunc) error
+	GetURL func(ctx context.Context, path string, next GetURLFunc) (string, error)
+
+	PutReader    func(ctx context.Context, path string, r io.Reader, next PutReaderFunc) error
+	GetReader    func(ctx context.Context, path string, next GetReaderFunc) (io.ReadCloser, error)
+	List         func(ctx context.Context, prefix string, next ListFunc) ([]string, error)
+	Stat         func(ctx context.Context, path string, next StatFunc) (FileInfo, error)
+	GetSignedURL func(ctx context.Context, path string, expiry time.Duration, next GetSignedURLFunc) (string, error)
+	Copy         func(ctx context.Context, src, dst string, next CopyFunc) error
+	GetMetadata  func(ctx context.Context, path string, next GetMetadataFunc) (map[string]string, error)
+	SetMetadata  func(ctx context.Context, path string, metadata map[string]string, next SetMetadataFunc) error
+	CheckHealth  func(ctx context.Context, next CheckHealthFunc) error
+
+	ListVersions   func(ctx context.Context, path string, next ListVersionsFunc) ([]Version, error)
+	GetVersion     func(ctx context.Context, path, versionID string, next GetVersionFunc) ([]byte, error)
+	RestoreVersion func(ctx context.Context, path, versionID string, next RestoreVersionFunc) error
+	DeleteVersion  func(ctx context.Context, path, versionID string, next DeleteVersionFunc) error
+}
+
+// Intercept returns a Middleware that applies the interceptor to a Disk.
+//
+// The decorated Disk implements exactly the optional interfaces (e.g. URLProvider)
+// that the wrapped Disk reports through Capabilities, so type assertions on the
+// decorated Disk behave like type assertions on the wrapped Disk.
+// The decorated Disk implements CapabilityReporter, so Capabilities reports the
+// capabilities of the wrapped Disk.
+func Intercept(interceptor Interceptor) Middleware {
+	return func(next Disk) Disk {
+		return newInterceptedDisk(next, interceptor, Capabilities(next))
+	}
+}
+
+//go:generate go run gen_intercept.go
+
+// newInterceptedDisk returns a Disk that applies the interceptor to next and
+// implements the optional interfaces of caps. caps may contain capabilities that
+// next does not have if the interceptor provides the operations of the interface.
+// Operations that neither next nor the interceptor provide return an UnimplementedError.
+func newInterceptedDisk(next Disk, interceptor Interceptor, caps Capability) Disk {
+	d := &interceptedDisk{
+		next:        next,
+		interceptor: interceptor,
+		caps:        caps & capAll,
+	}
+	return interceptedTypes[d.caps](d)
+}
+
+type interceptedDisk struct {
+	next        Disk
+	interceptor Interceptor
+	caps        Capability
+
+	// If resolve is set, the wrapped Disk is resolved on every operation instead of using next,
+	// and name is added to UnimplementedErrors (see (*Manager).On).
+	name    string
+	resolve func() (Disk, error)
+}
+
+// target returns the wrapped Disk.
+func (d *interceptedDisk) target() (Disk, error) {
+	if d.resolve == nil {
+		return d.next, nil
+	}
+	return d.resolve()
+}
+
+func (d *interceptedDisk) withName(err error) error {
+	if d.name == "" {
+		return err
+	}
+	return withDiskName(err, d.name)
+}
+
+func (d *interceptedDisk) Put(ctx context.Context, path string, b []byte) error {
+	disk, err := d.target()
+	if err != nil {
+		return err
+	}
+
+	if d.interceptor.Put == nil {
+		return disk.Put(ctx, path, b)
+	}
+	return d.interceptor.Put(ctx, path, b, disk.Put)
+}
+
+func (d *interceptedDisk) Get(ctx context.Context, path string) ([]byte, error) {
+	disk, err := d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.interceptor.Get == nil {
+		return disk.Get(ctx, path)
+	}
+	return d.interceptor.Get(ctx, path, disk.Get)
+}
+
+func (d *interceptedDisk) Delete(ctx context.Context, path string) error {
+	disk, err := d.target()
+	if err != nil {
+		return err
+	}
+
+	if d.interceptor.Delete == nil {
+		return disk.Delete(ctx, path)
+	}
+	return d.interceptor.Delete(ctx, path, disk.Delete)
+}
+
+// Capabilities returns the capabilities of the decorated Disk. If the wrapped Disk is
+// resolved on every operation, only the capabilities that it still has are returned.
+func (d *interceptedDisk) Capabilities() Capability {
+	if d.resolve == nil {
+		return d.caps
+	}
+
+	disk, err := d.resolve()
+	if err != nil {
+		return 0
+	}
+	return d.caps & Capabilities(disk)
+}
+
+// Unwrap returns the wrapped Disk.
+func (d *interceptedDisk) Unwrap() Disk {
+	disk, _ := d.target()
+	return disk
+}
+
+// The following types implement the optional interfaces for interceptedDisk.
+// The types in intercept_gen.go embed them depending on the capabilities of the Disk.
+
+type interceptedURLProvider struct{ d *interceptedDisk }
+
+func (a interceptedURLProvider) GetURL(ctx context.Context, path string) (string, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return "", err
+	}
+
+	next := func(context.Context, string) (string, error) {
+		return "", UnimplementedError{Interface: new(URLProvider)}
+	}
+	if urldisk, ok := disk.(URLProvider); ok {
+		next = urldisk.GetURL
+	}
+
+	var url string
+	if a.d.interceptor.GetURL == nil {
+		url, err = next(ctx, path)
+	} else {
+		url, err = a.d.interceptor.GetURL(ctx, path, next)
+	}
+	return url, a.d.withName(err)
+}
+
+type interceptedStreamer struct{ d *interceptedDisk }
+
+func (a interceptedStreamer) PutReader(ctx context.Context, path string, r io.Reader) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context, string, io.Reader) error {
+		return UnimplementedError{Interface: new(Streamer)}
+	}
+	if sdisk, ok := disk.(Streamer); ok {
+		next = sdisk.PutReader
+	}
+
+	if a.d.interceptor.PutReader == nil {
+		return a.d.withName(next(ctx, path, r))
+	}
+	return a.d.withName(a.d.interceptor.PutReader(ctx, path, r, next))
+}
+
+func (a interceptedStreamer) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(context.Context, string) (io.ReadCloser, error) {
+		return nil, UnimplementedError{Interface: new(Streamer)}
+	}
+	if sdisk, ok := disk.(Streamer); ok {
+		next = sdisk.GetReader
+	}
+
+	var r io.ReadCloser
+	if a.d.interceptor.GetReader == nil {
+		r, err = next(ctx, path)
+	} else {
+		r, err = a.d.interceptor.GetReader(ctx, path, next)
+	}
+	return r, a.d.withName(err)
+}
+
+type interceptedLister struct{ d *interceptedDisk }
+
+func (a interceptedLister) List(ctx context.Context, prefix string) ([]string, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(context.Context, string) ([]string, error) {
+		return nil, UnimplementedError{Interface: new(Lister)}
+	}
+	if ldisk, ok := disk.(Lister); ok {
+		next = ldisk.List
+	}
+
+	var paths []string
+	if a.d.interceptor.List == nil {
+		paths, err = next(ctx, prefix)
+	} else {
+		paths, err = a.d.interceptor.List(ctx, prefix, next)
+	}
+	return paths, a.d.withName(err)
+}
+
+type interceptedStater struct{ d *interceptedDisk }
+
+func (a interceptedStater) Stat(ctx context.Context, path string) (FileInfo, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	next := func(context.Context, string) (FileInfo, error) {
+		return FileInfo{}, UnimplementedError{Interface: new(Stater)}
+	}
+	if sdisk, ok := disk.(Stater); ok {
+		next = sdisk.Stat
+	}
+
+	var info FileInfo
+	if a.d.interceptor.Stat == nil {
+		info, err = next(ctx, path)
+	} else {
+		info, err = a.d.interceptor.Stat(ctx, path, next)
+	}
+	return info, a.d.withName(err)
+}
+
+type interceptedSignedURLProvider struct{ d *interceptedDisk }
+
+func (a interceptedSignedURLProvider) GetSignedURL(ctx context.Context, path string, expiry time.Duration) (string, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return "", err
+	}
+
+	next := func(context.Context, string, time.Duration) (string, error) {
+		return "", UnimplementedError{Interface: new(SignedURLProvider)}
+	}
+	if sdisk, ok := disk.(SignedURLProvider); ok {
+		next = sdisk.GetSignedURL
+	}
+
+	var url string
+	if a.d.interceptor.GetSignedURL == nil {
+		url, err = next(ctx, path, expiry)
+	} else {
+		url, err = a.d.interceptor.GetSignedURL(ctx, path, expiry, next)
+	}
+	return url, a.d.withName(err)
+}
+
+type interceptedCopier struct{ d *interceptedDisk }
+
+func (a interceptedCopier) Copy(ctx context.Context, src, dst string) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context, string, string) error {
+		return UnimplementedError{Interface: new(Copier)}
+	}
+	if cdisk, ok := disk.(Copier); ok {
+		next = cdisk.Copy
+	}
+
+	if a.d.interceptor.Copy == nil {
+		return a.d.withName(next(ctx, src, dst))
+	}
+	return a.d.withName(a.d.interceptor.Copy(ctx, src, dst, next))
+}
+
+type interceptedMetadataProvider struct{ d *interceptedDisk }
+
+func (a interceptedMetadataProvider) GetMetadata(ctx context.Context, path string) (map[string]string, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(context.Context, string) (map[string]string, error) {
+		return nil, UnimplementedError{Interface: new(MetadataProvider)}
+	}
+	if mdisk, ok := disk.(MetadataProvider); ok {
+		next = mdisk.GetMetadata
+	}
+
+	var md map[string]string
+	if a.d.interceptor.GetMetadata == nil {
+		md, err = next(ctx, path)
+	} else {
+		md, err = a.d.interceptor.GetMetadata(ctx, path, next)
+	}
+	return md, a.d.withName(err)
+}
+
+func (a interceptedMetadataProvider) SetMetadata(ctx context.Context, path string, metadata map[string]string) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context, string, map[string]string) error {
+		return UnimplementedError{Interface: new(MetadataProvider)}
+	}
+	if mdisk, ok := disk.(MetadataProvider); ok {
+		next = mdisk.SetMetadata
+	}
+
+	if a.d.interceptor.SetMetadata == nil {
+		return a.d.withName(next(ctx, path, metadata))
+	}
+	return a.d.withName(a.d.interceptor.SetMetadata(ctx, path, metadata, next))
+}
+
+type interceptedHealthChecker struct{ d *interceptedDisk }
+
+func (a interceptedHealthChecker) CheckHealth(ctx context.Context) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context) error {
+		return UnimplementedError{Interface: new(HealthChecker)}
+	}
+	if hdisk, ok := disk.(HealthChecker); ok {
+		next = hdisk.CheckHealth
+	}
+
+	if a.d.interceptor.CheckHealth == nil {
+		return a.d.withName(next(ctx))
+	}
+	return a.d.withName(a.d.interceptor.CheckHealth(ctx, next))
+}
+
+type interceptedVersioner struct{ d *interceptedDisk }
+
+func (a interceptedVersioner) ListVersions(ctx context.Context, path string) ([]Version, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(context.Context, string) ([]Version, error) {
+		return nil, UnimplementedError{Interface: new(Versioner)}
+	}
+	if vdisk, ok := disk.(Versioner); ok {
+		next = vdisk.ListVersions
+	}
+
+	var versions []Version
+	if a.d.interceptor.ListVersions == nil {
+		versions, err = next(ctx, path)
+	} else {
+		versions, err = a.d.interceptor.ListVersions(ctx, path, next)
+	}
+	return versions, a.d.withName(err)
+}
+
+func (a interceptedVersioner) GetVersion(ctx context.Context, path, versionID string) ([]byte, error) {
+	disk, err := a.d.target()
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(context.Context, string, string) ([]byte, error) {
+		return nil, UnimplementedError{Interface: new(Versioner)}
+	}
+	if vdisk, ok := disk.(Versioner); ok {
+		next = vdisk.GetVersion
+	}
+
+	var b []byte
+	if a.d.interceptor.GetVersion == nil {
+		b, err = next(ctx, path, versionID)
+	} else {
+		b, err = a.d.interceptor.GetVersion(ctx, path, versionID, next)
+	}
+	return b, a.d.withName(err)
+}
+
+func (a interceptedVersioner) RestoreVersion(ctx context.Context, path, versionID string) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context, string, string) error {
+		return UnimplementedError{Interface: new(Versioner)}
+	}
+	if vdisk, ok := disk.(Versioner); ok {
+		next = vdisk.RestoreVersion
+	}
+
+	if a.d.interceptor.RestoreVersion == nil {
+		return a.d.withName(next(ctx, path, versionID))
+	}
+	return a.d.withName(a.d.interceptor.RestoreVersion(ctx, path, versionID, next))
+}
+
+func (a interceptedVersioner) DeleteVersion(ctx context.Context, path, versionID string) error {
+	disk, err := a.d.target()
+	if err != nil {
+		return err
+	}
+
+	next := func(context.Context, string, string) error {
+		return UnimplementedError{Interface: new(Versioner)}
+	}
+	if vdisk, ok := disk.(Versioner); ok {
+		next = vdisk.DeleteVersion
+	}
+
+	if a.d.interceptor.DeleteVersion == nil {
+		return a.d.withName(next(ctx, path, versionID))
+	}
+	return a.d.withName(a.d.interceptor.DeleteVersion(ctx, path, versionID, next))
+}
+
+type scopedMiddleware struct {
+	// disks contains the names of the Disks the middleware applies to.
+	// If disks is empty, the middleware applies to all Disks.
+	disks []string
+	apply func(name string, next Disk) Disk
+}
+
+func (mw scopedMiddleware) appliesTo(name string) bool {
+	if len(mw.disks) == 0 {
+		return true
+	}
+	for _, disk := range mw.disks {
+		if disk == name {
+			return true
+		}
+	}
+	return false
+}
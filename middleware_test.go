@@ -0,0 +1,171 @@
+package godrive_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrap(t *testing.T) {
+	var calls []string
+	trace := func(name string) godrive.Middleware {
+		return godrive.Intercept(godrive.Interceptor{
+			Put: func(ctx context.Context, path string, b []byte, next godrive.PutFunc) error {
+				calls = append(calls, name)
+				return next(ctx, path, b)
+			},
+		})
+	}
+
+	disk := godrive.Wrap(memdisk.New(), trace("first"), trace("second"))
+
+	err := disk.Put(context.Background(), "file.txt", []byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestIntercept(t *testing.T) {
+	upper := godrive.Intercept(godrive.Interceptor{
+		Get: func(ctx context.Context, path string, next godrive.GetFunc) ([]byte, error) {
+			b, err := next(ctx, path)
+			return []byte(strings.ToUpper(string(b))), err
+		},
+		GetURL: func(ctx context.Context, path string, next godrive.GetURLFunc) (string, error) {
+			url, err := next(ctx, path)
+			return url + "?intercepted", err
+		},
+	})
+
+	disk := upper(urlDisk{memdisk.New()})
+
+	assert.Nil(t, disk.Put(context.Background(), "file.txt", []byte("hello")))
+
+	b, err := disk.Get(context.Background(), "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "HELLO", string(b))
+
+	urldisk, ok := disk.(godrive.URLProvider)
+	assert.True(t, ok)
+
+	url, err := urldisk.GetURL(context.Background(), "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.test/file.txt?intercepted", url)
+}
+
+func TestIntercept_interfaces(t *testing.T) {
+	disk := godrive.Intercept(godrive.Interceptor{})(memdisk.New())
+
+	_, ok := disk.(godrive.URLProvider)
+	assert.False(t, ok)
+	_, ok = disk.(godrive.Streamer)
+	assert.False(t, ok)
+	assert.Equal(t, godrive.Capability(0), godrive.Capabilities(disk))
+
+	disk = godrive.Wrap(urlDisk{memdisk.New()}, godrive.Intercept(godrive.Interceptor{}), godrive.Intercept(godrive.Interceptor{}))
+
+	_, ok = disk.(godrive.URLProvider)
+	assert.True(t, ok)
+	_, ok = disk.(godrive.Streamer)
+	assert.False(t, ok)
+	assert.Equal(t, godrive.CapURL, godrive.Capabilities(disk))
+}
+
+func TestManager_Use(t *testing.T) {
+	var calls []string
+	trace := func(name string) godrive.Middleware {
+		return godrive.Intercept(godrive.Interceptor{
+			Delete: func(ctx context.Context, path string, next godrive.DeleteFunc) error {
+				calls = append(calls, name)
+				return next(ctx, path)
+			},
+		})
+	}
+
+	m := godrive.New()
+	m.Configure("main", memdisk.New())
+	m.Use(trace("all"))
+	m.UseFor([]string{"videos"}, trace("videos"))
+	m.Configure("videos", memdisk.New())
+
+	main, _ := m.Disk("main")
+	assert.Nil(t, main.Delete(context.Background(), "file.txt"))
+	assert.Equal(t, []string{"all"}, calls)
+
+	calls = nil
+	videos, _ := m.Disk("videos")
+	assert.Nil(t, videos.Delete(context.Background(), "file.txt"))
+	assert.Equal(t, []string{"all", "videos"}, calls)
+}
+
+func TestManager_GetURL_unimplemented(t *testing.T) {
+	m := godrive.New()
+	m.Configure("main", memdisk.New())
+	m.Use(godrive.Intercept(godrive.Interceptor{}))
+
+	_, err := m.GetURL(context.Background(), "file.txt")
+
+	var unimplemented godrive.UnimplementedError
+	assert.True(t, errors.As(err, &unimplemented))
+	assert.Equal(t, "main", unimplemented.DiskName)
+}
+
+func TestAutoWireConfig_middleware(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	cfg.RegisterProvider("memory", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		return memdisk.New(), nil
+	}))
+
+	var prefixes []string
+	cfg.RegisterMiddleware("prefix", godrive.MiddlewareCreatorFunc(func(_ context.Context, cfg map[string]interface{}) (godrive.Middleware, error) {
+		prefix := cfg["prefix"].(string)
+		prefixes = append(prefixes, prefix)
+		return godrive.Intercept(godrive.Interceptor{
+			Put: func(ctx context.Context, path string, b []byte, next godrive.PutFunc) error {
+				return next(ctx, prefix+path, b)
+			},
+		}), nil
+	}))
+
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+default: main
+disks:
+  main:
+    provider: memory
+  other:
+    provider: memory
+middleware:
+  - name: prefix
+    disks: [main]
+    config:
+      prefix: tenant/
+`))
+	assert.Nil(t, err)
+
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tenant/"}, prefixes)
+
+	assert.Nil(t, m.Put(context.Background(), "file.txt", []byte("hello")))
+
+	b, err := m.Get(context.Background(), "tenant/file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	other, _ := m.Disk("other")
+	assert.Nil(t, other.Put(context.Background(), "file.txt", []byte("hello")))
+	_, err = other.Get(context.Background(), "file.txt")
+	assert.Nil(t, err)
+}
+
+func TestAutoWireConfig_unregisteredMiddleware(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	cfg.UseMiddleware("retry", nil)
+
+	_, err := cfg.NewManager(context.Background())
+	assert.ErrorIs(t, err, godrive.UnregisteredMiddlewareError{Name: "retry"})
+}
@@ -0,0 +1,288 @@
+package godrive
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// applyEnvVars replaces the placeholders in the string values of cfg.
+// Supported placeholders are:
+//
+//	${VAR}                 value of the environment variable VAR (empty if unset)
+//	${VAR:-default}        value of VAR, or "default" if VAR is unset or empty
+//	${VAR:?error message}  value of VAR, or an error if VAR is unset or empty
+//	$${VAR}                the literal string "${VAR}"
+//
+// Values that contain a secret placeholder (${scheme:ref}, e.g. ${file:/run/secrets/key})
+// are left untouched and resolved by (*AutoWireConfig).NewManager (see SecretResolver),
+// including the other placeholders in those values.
+//
+// If a value consists of a single placeholder and the schema field for the key has a
+// non-string type, the result is converted to that type. All other values stay strings,
+// including the values of keys without a field and the values in nested maps and lists.
+func applyEnvVars(cfg map[string]interface{}, schema ConfigSchema) error {
+	return replacePlaceholders(cfg, schema, resolveEnvPlaceholder, false)
+}
+
+// resolveSecrets returns a copy of cfg where the values that contain secret placeholders are replaced.
+func (cfg *AutoWireConfig) resolveSecrets(ctx context.Context, config map[string]interface{}, schema ConfigSchema) (map[string]interface{}, error) {
+	resolved := copyConfig(config)
+
+	if err := replacePlaceholders(resolved, schema, func(expr string) (string, error) {
+		scheme, ref, ok := secretRef(expr)
+		if !ok {
+			return resolveEnvPlaceholder(expr)
+		}
+
+		resolver, ok := cfg.SecretResolvers[scheme]
+		if !ok {
+			return "", UnregisteredSecretResolverError{Scheme: scheme}
+		}
+
+		secret, err := resolver.ResolveSecret(ctx, ref)
+		if err != nil {
+			return "", fmt.Errorf("resolve secret '%s:%s': %w", scheme, ref, err)
+		}
+
+		return secret, nil
+	}, true); err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// replacePlaceholders replaces the placeholders in the string values of cfg.
+// Values that contain a (possibly escaped) secret placeholder are resolved lazily, so that every
+// value is replaced exactly once: if lazy is false, only the other values are replaced,
+// and if lazy is true, only the values with secret placeholders are replaced.
+func replacePlaceholders(cfg map[string]interface{}, schema ConfigSchema, resolve func(string) (string, error), lazy bool) error {
+	replace := func(val string, schema ConfigSchema, key string) (interface{}, error) {
+		if secretPlaceholderExpr.MatchString(val) != lazy {
+			return val, nil
+		}
+		return replaceEnvPlaceholders(val, schema, key, resolve)
+	}
+
+	for key, val := range cfg {
+		switch v := val.(type) {
+		case map[string]interface{}:
+			if err := replacePlaceholders(v, ConfigSchema{}, resolve, lazy); err != nil {
+				return fmt.Errorf("%s.%w", key, err)
+			}
+		case []interface{}:
+			for i, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					continue
+				}
+
+				replaced, err := replace(s, ConfigSchema{}, "")
+				if err != nil {
+					return fmt.Errorf("%s[%d]: %w", key, i, err)
+				}
+				v[i] = replaced
+			}
+		case string:
+			replaced, err := replace(v, schema, key)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			cfg[key] = replaced
+		}
+	}
+
+	return nil
+}
+
+// replaceEnvPlaceholders replaces the placeholders in val.
+// If val consists of a single placeholder and the schema field for key has a non-string type,
+// the result is converted to the type of the field.
+func replaceEnvPlaceholders(val string, schema ConfigSchema, key string, resolve func(string) (string, error)) (interface{}, error) {
+	var out strings.Builder
+	var placeholders int
+	var whole bool
+
+	for i := 0; i < len(val); {
+		if strings.HasPrefix(val[i:], "$${") {
+			end := strings.IndexByte(val[i:], '}')
+			if end < 0 {
+				out.WriteString(val[i:])
+				break
+			}
+			out.WriteString(val[i+1 : i+end+1])
+			i += end + 1
+			continue
+		}
+
+		if !strings.HasPrefix(val[i:], "${") {
+			out.WriteByte(val[i])
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(val[i:], '}')
+		if end < 0 {
+			return nil, PlaceholderSyntaxError{Value: val, Details: "missing closing brace"}
+		}
+
+		replaced, err := resolve(val[i+2 : i+end])
+		if err != nil {
+			return nil, err
+		}
+
+		placeholders++
+		whole = i == 0 && end == len(val)-1
+		out.WriteString(replaced)
+		i += end + 1
+	}
+
+	if field, ok := schema.Field(key); ok && field.Type != TypeString && placeholders == 1 && whole {
+		return coerceEnvValue(out.String(), schema, key, nil)
+	}
+
+	return out.String(), nil
+}
+
+func resolveEnvPlaceholder(expr string) (string, error) {
+	name, modifier, hasModifier := strings.Cut(expr, ":")
+	if name == "" {
+		return "", PlaceholderSyntaxError{Value: "${" + expr + "}", Details: "missing variable name"}
+	}
+
+	val := os.Getenv(name)
+	if !hasModifier {
+		return val, nil
+	}
+
+	switch {
+	case strings.HasPrefix(modifier, "-"):
+		if val == "" {
+			return modifier[1:], nil
+		}
+		return val, nil
+	case strings.HasPrefix(modifier, "?"):
+		if val == "" {
+			return "", MissingEnvError{Var: name, Message: modifier[1:]}
+		}
+		return val, nil
+	default:
+		return "", PlaceholderSyntaxError{
+			Value:   "${" + expr + "}",
+			Details: fmt.Sprintf("unknown modifier '%s'", modifier),
+		}
+	}
+}
+
+var (
+	secretPlaceholderExpr = regexp.MustCompile(`\${([A-Za-z][A-Za-z0-9+.-]*):([^-?}][^}]*)}`)
+	secretRefExpr         = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9+.-]*):([^-?].*)$`)
+)
+
+// secretRef splits the expression of a secret placeholder into scheme and reference.
+// It returns false if expr is an environment variable placeholder.
+func secretRef(expr string) (string, string, bool) {
+	match := secretRefExpr.FindStringSubmatch(expr)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+// findSecretPlaceholders returns the schemes of the unescaped secret placeholders in val.
+func findSecretPlaceholders(val string) []string {
+	var schemes []string
+	for _, match := range secretPlaceholderExpr.FindAllStringSubmatchIndex(val, -1) {
+		if match[0] > 0 && val[match[0]-1] == '$' {
+			continue
+		}
+		schemes = append(schemes, val[match[2]:match[3]])
+	}
+	return schemes
+}
+
+func hasSecretPlaceholder(val string) bool {
+	return len(findSecretPlaceholders(val)) > 0
+}
+
+// secretSchemes returns the schemes of all secret placeholders in the string values of cfg.
+func secretSchemes(cfg map[string]interface{}) []string {
+	var schemes []string
+	var collect func(val interface{})
+	collect = func(val interface{}) {
+		switch v := val.(type) {
+		case map[string]interface{}:
+			for _, val := range v {
+				collect(val)
+			}
+		case []interface{}:
+			for _, val := range v {
+				collect(val)
+			}
+		case string:
+			schemes = append(schemes, findSecretPlaceholders(v)...)
+		}
+	}
+	collect(cfg)
+	return schemes
+}
+
+func copyConfig(cfg map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(cfg))
+	for key, val := range cfg {
+		switch v := val.(type) {
+		case map[string]interface{}:
+			out[key] = copyConfig(v)
+		case []interface{}:
+			out[key] = append([]interface{}(nil), v...)
+		default:
+			out[key] = v
+		}
+	}
+	return out
+}
+
+// PlaceholderError means a placeholder in the configuration of a disk or middleware could not be replaced.
+type PlaceholderError struct {
+	DiskName   string
+	Middleware string
+	Err        error
+}
+
+func (err PlaceholderError) Error() string {
+	if err.Middleware != "" {
+		return fmt.Sprintf("replace placeholders for middleware '%s': %v", err.Middleware, err.Err)
+	}
+	return fmt.Sprintf("replace placeholders for disk '%s': %v", err.DiskName, err.Err)
+}
+
+// Unwrap returns the underlying error.
+func (err PlaceholderError) Unwrap() error {
+	return err.Err
+}
+
+// MissingEnvError is returned for a ${VAR:?message} placeholder if VAR is unset or empty.
+type MissingEnvError struct {
+	Var     string
+	Message string
+}
+
+func (err MissingEnvError) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("environment variable '%s' must be set", err.Var)
+	}
+	return fmt.Sprintf("%s: %s", err.Var, err.Message)
+}
+
+// PlaceholderSyntaxError means a placeholder is malformed.
+type PlaceholderSyntaxError struct {
+	Value   string
+	Details string
+}
+
+func (err PlaceholderSyntaxError) Error() string {
+	return fmt.Sprintf("invalid placeholder in '%s': %s", err.Value, err.Details)
+}
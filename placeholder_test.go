@@ -0,0 +1,118 @@
+package godrive_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadYAMLReader_placeholders(t *testing.T) {
+	t.Setenv("BUCKET", "12345")
+	t.Setenv("PUBLIC", "true")
+	t.Setenv("RETRIES", "3")
+	t.Setenv("EMPTY", "")
+
+	cfg := godrive.NewAutoWire(s3.Register)
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  main:
+    provider: s3
+    config:
+      region: ${REGION:-us-east-2}
+      bucket: ${BUCKET:?bucket must be set}
+      accessKeyId: ${EMPTY:-default-key}
+      secretAccessKey: ${file:/run/secrets/aws}
+      public: ${PUBLIC}
+  other:
+    provider: other
+    config:
+      retries: ${RETRIES}
+      url: https://${BUCKET}.test/$${PATH}
+      tags: ["${BUCKET}", plain]
+      nested:
+        enabled: ${PUBLIC}
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"region":          "us-east-2",
+		"bucket":          "12345",
+		"accessKeyId":     "default-key",
+		"secretAccessKey": "${file:/run/secrets/aws}", // resolved by NewManager
+		"public":          true,
+	}, cfg.Disks["main"].Config)
+
+	// Without a schema field, the values are not converted.
+	assert.Equal(t, map[string]interface{}{
+		"retries": "3",
+		"url":     "https://12345.test/${PATH}",
+		"tags":    []interface{}{"12345", "plain"},
+		"nested":  map[string]interface{}{"enabled": "true"},
+	}, cfg.Disks["other"].Config)
+}
+
+func TestLoadYAMLReader_placeholderStrings(t *testing.T) {
+	t.Setenv("API_KEY", "0012345")
+	t.Setenv("RATIO", "inf")
+
+	cfg := godrive.NewAutoWire()
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  main:
+    provider: http
+    config:
+      headers:
+        X-Api-Key: ${API_KEY}
+      ratio: ${RATIO}
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"headers": map[string]interface{}{"X-Api-Key": "0012345"},
+		"ratio":   "inf",
+	}, cfg.Disks["main"].Config)
+}
+
+func TestLoadYAMLReader_placeholderErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		expect func(*testing.T, error)
+	}{
+		{
+			name:  "required",
+			value: "${GODRIVE_UNSET_BUCKET:?bucket must be set}",
+			expect: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, godrive.MissingEnvError{Var: "GODRIVE_UNSET_BUCKET", Message: "bucket must be set"})
+				assert.Contains(t, err.Error(), "disk 'main'")
+				assert.Contains(t, err.Error(), "bucket must be set")
+			},
+		},
+		{
+			name:  "unclosed",
+			value: "${BUCKET",
+			expect: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &godrive.PlaceholderSyntaxError{}))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := godrive.NewAutoWire()
+			err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  main:
+    provider: s3
+    config:
+      bucket: "` + test.value + `"
+`))
+			assert.True(t, errors.As(err, &godrive.PlaceholderError{}))
+			test.expect(t, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package godrive
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// LoadOption is an option for loading the disk configuration.
+type LoadOption func(*loadConfig)
+
+type loadConfig struct {
+	profile string
+}
+
+// Profile selects a named profile of the configuration. The settings of the
+// profile are deep-merged over the base configuration before the disks are configured:
+//
+//	default: main
+//	disks:
+//	  main:
+//	    provider: s3
+//	    config:
+//	      region: us-east-2
+//	      bucket: dev-images
+//	profiles:
+//	  prod:
+//	    disks:
+//	      main:
+//	        config:
+//	          bucket: prod-images
+//
+// Maps are merged recursively, all other values (including lists) are replaced.
+// A null value removes the key, e.g. to remove a disk in a profile.
+func Profile(name string) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.profile = name
+	}
+}
+
+// resolveIncludes merges the files listed under the "include" key into raw.
+// Included files are merged in order and the including configuration is merged
+// over them, so it overrides the settings of the included files.
+// Paths are relative to dir. Includes may be nested but must not be cyclic.
+func (cfg *AutoWireConfig) resolveIncludes(raw map[string]interface{}, dir string, seen []string) (map[string]interface{}, error) {
+	rinclude, ok := raw["include"]
+	if !ok {
+		return raw, nil
+	}
+	delete(raw, "include")
+
+	var includes []string
+	switch v := rinclude.(type) {
+	case string:
+		includes = []string{v}
+	case []interface{}:
+		for _, rpath := range v {
+			path, ok := rpath.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid configuration: 'include' must only contain strings but contains a '%T'", rpath)
+			}
+			includes = append(includes, path)
+		}
+	default:
+		return nil, fmt.Errorf("invalid configuration: 'include' must be a string or a list but is a '%T'", rinclude)
+	}
+
+	merged := make(map[string]interface{})
+	for _, path := range includes {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		for _, s := range seen {
+			if abs(s) == abs(path) {
+				return nil, fmt.Errorf("cyclic include of '%s'", path)
+			}
+		}
+
+		dec, err := cfg.decoderFor(path)
+		if err != nil {
+			return nil, err
+		}
+
+		included, err := readRawConfig(path, dec)
+		if err != nil {
+			return nil, fmt.Errorf("include '%s': %w", path, err)
+		}
+		cfg.includes = append(cfg.includes, path)
+
+		if included, err = cfg.resolveIncludes(included, filepath.Dir(path), append(seen, path)); err != nil {
+			return nil, err
+		}
+
+		merged = mergeConfig(merged, included)
+	}
+
+	return mergeConfig(merged, raw), nil
+}
+
+func abs(path string) string {
+	if p, err := filepath.Abs(path); err == nil {
+		return p
+	}
+	return path
+}
+
+// selectProfile merges the profile with the given name over raw and removes the "profiles" key.
+func selectProfile(raw map[string]interface{}, profile string) (map[string]interface{}, error) {
+	rprofiles, ok := raw["profiles"]
+	delete(raw, "profiles")
+
+	if profile == "" {
+		return raw, nil
+	}
+
+	profiles, ok := rprofiles.(map[string]interface{})
+	if !ok {
+		return nil, UnknownProfileError{Profile: profile}
+	}
+
+	rselected, ok := profiles[profile]
+	if !ok {
+		return nil, UnknownProfileError{Profile: profile}
+	}
+
+	selected, ok := rselected.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid configuration: profile '%s' must be a map but is a '%T'", profile, rselected)
+	}
+
+	return mergeConfig(raw, selected), nil
+}
+
+// mergeConfig deep-merges overlay over base and returns the result.
+// Neither base nor overlay are modified.
+func mergeConfig(base, overlay map[string]interface{}) map[string]interface{} {
+	out := copyConfig(base)
+
+	for key, val := range overlay {
+		if val == nil {
+			delete(out, key)
+			continue
+		}
+
+		bmap, bok := out[key].(map[string]interface{})
+		omap, ook := val.(map[string]interface{})
+		if bok && ook {
+			out[key] = mergeConfig(bmap, omap)
+			continue
+		}
+
+		if ook {
+			out[key] = copyConfig(omap)
+			continue
+		}
+
+		out[key] = val
+	}
+
+	return out
+}
+
+// UnknownProfileError means a profile was selected that is not defined in the configuration.
+type UnknownProfileError struct {
+	Profile string
+}
+
+func (err UnknownProfileError) Error() string {
+	return fmt.Sprintf("unknown configuration profile '%s'", err.Profile)
+}
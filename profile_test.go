@@ -0,0 +1,91 @@
+package godrive_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_include(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	assert.Nil(t, cfg.Load(filepath.Join("testdata", "profiles", "autowire.yml")))
+
+	assert.Equal(t, "images", cfg.DefaultDiskName)
+	assert.Equal(t, map[string]interface{}{
+		"region":          "us-east-2",
+		"public":          false,
+		"bucket":          "images",
+		"accessKeyId":     "key",
+		"secretAccessKey": "secret",
+	}, cfg.Disks["images"].Config)
+	assert.Equal(t, "dev-videos", cfg.Disks["videos"].Config["bucket"])
+}
+
+func TestLoad_profile(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	assert.Nil(t, cfg.Load(filepath.Join("testdata", "profiles", "autowire.yml"), godrive.Profile("prod")))
+
+	images := cfg.Disks["images"]
+	assert.Equal(t, "s3", images.Provider)
+	assert.Equal(t, map[string]interface{}{
+		"region":          "us-east-2",
+		"public":          true,
+		"bucket":          "prod-images",
+		"accessKeyId":     "key",
+		"secretAccessKey": "secret",
+	}, images.Config)
+
+	videos := cfg.Disks["videos"]
+	assert.Equal(t, "gcs", videos.Provider)
+	assert.Equal(t, "prod-videos", videos.Config["bucket"])
+	assert.Equal(t, "/path/to/service/account.json", videos.Config["serviceAccount"])
+}
+
+func TestLoad_profileRemovesDisk(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	assert.Nil(t, cfg.Load(filepath.Join("testdata", "profiles", "autowire.yml"), godrive.Profile("nogcs")))
+
+	_, ok := cfg.Disks["videos"]
+	assert.False(t, ok)
+	assert.Contains(t, cfg.Disks, "images")
+}
+
+func TestLoad_unknownProfile(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	err := cfg.Load(filepath.Join("testdata", "profiles", "autowire.yml"), godrive.Profile("staging"))
+
+	var perr godrive.UnknownProfileError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, "staging", perr.Profile)
+}
+
+func TestLoad_cyclicInclude(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	err := cfg.Load(filepath.Join("testdata", "profiles", "cycle-a.yml"))
+	assert.ErrorContains(t, err, "cyclic include")
+}
+
+func TestLoadReader_profile(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  main:
+    provider: s3
+    config:
+      bucket: dev
+profiles:
+  prod:
+    default: main
+    disks:
+      main:
+        config:
+          bucket: prod
+`), godrive.Profile("prod"))
+	assert.Nil(t, err)
+	assert.Equal(t, "main", cfg.DefaultDiskName)
+	assert.Equal(t, "prod", cfg.Disks["main"].Config["bucket"])
+}
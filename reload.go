@@ -0,0 +1,409 @@
+package godrive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce is the time to wait for further file events before the configuration is reloaded.
+// Editors and tools like Kubernetes often write a file in multiple steps.
+const reloadDebounce = 100 * time.Millisecond
+
+// WatchOption is an option for (*AutoWireConfig).Watch().
+type WatchOption func(*watchConfig)
+
+type watchConfig struct {
+	pollInterval time.Duration
+	loadOptions  []LoadOption
+	onReload     func(ReloadEvent)
+}
+
+// Polling makes Watch poll the modification time and size of the configuration file
+// in the given interval instead of using file system notifications.
+// Use it on file systems that don't support notifications (e.g. network file systems).
+func Polling(interval time.Duration) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.pollInterval = interval
+	}
+}
+
+// WatchLoadOptions sets the options that are used to load the configuration file, e.g. Profile.
+func WatchLoadOptions(options ...LoadOption) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.loadOptions = append(cfg.loadOptions, options...)
+	}
+}
+
+// OnReload registers a function that is called after every reload attempt.
+func OnReload(fn func(ReloadEvent)) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.onReload = fn
+	}
+}
+
+// ReloadEvent describes a reload of the disk configuration.
+// If the reload failed, Err is set and the Manager is unchanged.
+type ReloadEvent struct {
+	Added   []string
+	Changed []string
+	Removed []string
+	Err     error
+}
+
+// Watch watches the configuration file at path and the files it includes and reloads the Disks
+// of m when one of the files changes.
+// m should be created by cfg.NewManager and cfg should contain the configuration of the file
+// (the configuration that is compared against the first change of the file).
+//
+// On every change, the file is loaded and validated and only the Disks whose provider or
+// configuration (before secrets are resolved) changed are created again, using the InitMode of cfg.
+// The environment variables of previous (*AutoWireConfig).LoadEnv calls are applied again over
+// the loaded file, so they keep overriding it.
+// Created, changed and removed Disks are swapped in at once, so operations of the Manager see
+// either the old or the new configuration. Replaced Disks that implement io.Closer are closed.
+// If any Disk cannot be created, the Manager is left unchanged.
+// The default Disk is only changed if the file changes it or if the default Disk is removed,
+// so a default Disk that was set with (*Manager).SetDefault is kept.
+// The middleware configuration is not reloaded.
+//
+// Watch blocks until ctx is canceled. It returns an error only if the files cannot be watched.
+func (cfg *AutoWireConfig) Watch(ctx context.Context, m *Manager, path string, options ...WatchOption) error {
+	var wcfg watchConfig
+	for _, opt := range options {
+		opt(&wcfg)
+	}
+
+	// The configurations are compared before secrets are resolved, so that no secrets are kept in memory.
+	current := watchState{
+		disks:       make(map[string]DiskCreatorConfig, len(cfg.Disks)),
+		defaultDisk: cfg.defaultDiskName(),
+		files:       cfg.configFiles(path, wcfg.loadOptions),
+	}
+	for diskname, diskcfg := range cfg.Disks {
+		current.disks[diskname] = DiskCreatorConfig{Provider: diskcfg.Provider, Config: copyConfig(diskcfg.Config)}
+	}
+
+	for ctx.Err() == nil {
+		files := current.files
+
+		// The watcher is restarted when the included files change.
+		wctx, cancel := context.WithCancel(ctx)
+		changes, err := watchFiles(wctx, files, wcfg.pollInterval)
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		for range changes {
+			evt := cfg.reload(ctx, m, path, wcfg.loadOptions, &current)
+			if wcfg.onReload != nil {
+				wcfg.onReload(evt)
+			}
+
+			if !slices.Equal(files, current.files) {
+				cancel()
+			}
+		}
+		cancel()
+	}
+
+	return nil
+}
+
+// watchState is the configuration of the file that was loaded last.
+type watchState struct {
+	disks       map[string]DiskCreatorConfig
+	defaultDisk string
+	// files are the configuration file and the files it includes.
+	files []string
+}
+
+// configFiles returns path and the files that are included by the configuration file at path.
+// If the file cannot be loaded, only path is returned.
+func (cfg *AutoWireConfig) configFiles(path string, options []LoadOption) []string {
+	next := cfg.withoutDisks()
+	if err := next.Load(path, options...); err != nil {
+		return []string{path}
+	}
+	return append([]string{path}, next.includes...)
+}
+
+// reload loads the configuration file and applies the changes to m.
+// On success, current is updated to the new configuration.
+func (cfg *AutoWireConfig) reload(ctx context.Context, m *Manager, path string, options []LoadOption, current *watchState) ReloadEvent {
+	next := cfg.withoutDisks()
+	if err := next.Load(path, options...); err != nil {
+		return ReloadEvent{Err: err}
+	}
+
+	for _, prefix := range cfg.envPrefixes {
+		if err := next.LoadEnv(prefix); err != nil {
+			return ReloadEvent{Err: err}
+		}
+	}
+
+	if err := next.Validate(); err != nil {
+		return ReloadEvent{Err: err}
+	}
+
+	var evt ReloadEvent
+	created := make(map[string]Disk)
+	pending := make(map[string]func() (Disk, error))
+
+	for _, diskname := range sortedKeys(next.Disks) {
+		diskcfg := next.Disks[diskname]
+
+		prev, ok := current.disks[diskname]
+		if ok && reflect.DeepEqual(prev, diskcfg) {
+			continue
+		}
+
+		if next.Init == InitLazy {
+			pending[diskname] = next.lazyCreate(context.WithoutCancel(ctx), diskname, diskcfg)
+		} else {
+			config, err := next.resolveDiskConfig(ctx, diskname, diskcfg)
+			if err != nil {
+				evt.Err = err
+				break
+			}
+
+			disk, err := next.createDisk(ctx, diskcfg.Provider, config)
+			if err != nil {
+				evt.Err = DiskReloadError{DiskName: diskname, Err: err}
+				break
+			}
+			created[diskname] = disk
+		}
+
+		if ok {
+			evt.Changed = append(evt.Changed, diskname)
+		} else {
+			evt.Added = append(evt.Added, diskname)
+		}
+	}
+
+	if evt.Err != nil {
+		for _, disk := range created {
+			closeDisk(disk)
+		}
+		return ReloadEvent{Err: evt.Err}
+	}
+
+	for _, diskname := range sortedKeys(current.disks) {
+		if _, ok := next.Disks[diskname]; !ok {
+			evt.Removed = append(evt.Removed, diskname)
+		}
+	}
+
+	providers := make(map[string]string, len(created)+len(pending))
+	for diskname := range created {
+		providers[diskname] = next.Disks[diskname].Provider
+	}
+	for diskname := range pending {
+		providers[diskname] = next.Disks[diskname].Provider
+	}
+
+	defaultDisk := next.defaultDiskName()
+	for _, disk := range m.swap(created, pending, providers, evt.Removed, defaultDisk, defaultDisk != current.defaultDisk) {
+		closeDisk(disk)
+	}
+
+	current.disks = next.Disks
+	current.defaultDisk = defaultDisk
+	current.files = append([]string{path}, next.includes...)
+
+	return evt
+}
+
+// withoutDisks returns a copy of cfg with the registered providers, schemas, decoders etc., but without disks.
+func (cfg *AutoWireConfig) withoutDisks() *AutoWireConfig {
+	return &AutoWireConfig{
+		Disks:              make(map[string]DiskCreatorConfig),
+		Creators:           cfg.Creators,
+		MiddlewareCreators: cfg.MiddlewareCreators,
+		Schemas:            cfg.Schemas,
+		Decoders:           cfg.Decoders,
+		SecretResolvers:    cfg.SecretResolvers,
+		Init:               cfg.Init,
+		envPrefixes:        cfg.envPrefixes,
+	}
+}
+
+// swap configures the created and pending Disks, removes the Disks with the given names and sets the
+// default Disk at once. The default Disk is only set if setDefault is true or if the current default
+// Disk is removed. It returns the replaced and removed Disks.
+func (m *Manager) swap(created map[string]Disk, pending map[string]func() (Disk, error), providers map[string]string, removed []string, defaultDisk string, setDefault bool) []Disk {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	var old []Disk
+	for name, disk := range created {
+		if prev, ok := m.disks[name]; ok {
+			old = append(old, prev)
+		}
+		delete(m.pending, name)
+		m.disks[name] = disk
+		m.providers[name] = providers[name]
+		m.wrap(name)
+	}
+
+	for name, create := range pending {
+		if prev, ok := m.disks[name]; ok {
+			old = append(old, prev)
+		}
+		delete(m.disks, name)
+		delete(m.wrapped, name)
+		m.pending[name] = &lazyDisk{create: create}
+		m.providers[name] = providers[name]
+	}
+
+	for _, name := range removed {
+		if prev, ok := m.disks[name]; ok {
+			old = append(old, prev)
+		}
+		delete(m.disks, name)
+		delete(m.wrapped, name)
+		delete(m.pending, name)
+		delete(m.providers, name)
+	}
+
+	if setDefault || (m.defaultDisk != "" && !m.configured(m.defaultDisk)) {
+		m.defaultDisk = defaultDisk
+	}
+
+	return old
+}
+
+func closeDisk(disk Disk) {
+	if c, ok := disk.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+// watchFiles returns a channel that receives a value when one of the files at paths changes.
+// The channel is closed when ctx is canceled.
+func watchFiles(ctx context.Context, paths []string, pollInterval time.Duration) (<-chan struct{}, error) {
+	if pollInterval > 0 {
+		return pollFiles(ctx, paths, pollInterval)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("watch config file: %w", err)
+	}
+
+	// Watch the directories instead of the files, because editors and Kubernetes
+	// replace files instead of writing to them.
+	names := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		names[filepath.Clean(path)] = true
+		if err := watcher.Add(filepath.Dir(path)); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("watch config file: %w", err)
+		}
+	}
+
+	changes := make(chan struct{})
+
+	go func() {
+		defer close(changes)
+		defer watcher.Close()
+
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if names[filepath.Clean(evt.Name)] || filepath.Base(evt.Name) == "..data" {
+					debounce = time.After(reloadDebounce)
+				}
+			case <-watcher.Errors:
+			case <-debounce:
+				debounce = nil
+				select {
+				case changes <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func pollFiles(ctx context.Context, paths []string, interval time.Duration) (<-chan struct{}, error) {
+	stats := make([]os.FileInfo, len(paths))
+	for i, path := range paths {
+		stat, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("watch config file: %w", err)
+		}
+		stats[i] = stat
+	}
+
+	changes := make(chan struct{})
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			var changed bool
+			for i, path := range paths {
+				next, err := os.Stat(path)
+				if err != nil || (next.ModTime().Equal(stats[i].ModTime()) && next.Size() == stats[i].Size()) {
+					continue
+				}
+				stats[i] = next
+				changed = true
+			}
+			if !changed {
+				continue
+			}
+
+			select {
+			case changes <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// DiskReloadError means a Disk could not be created while reloading the configuration.
+type DiskReloadError struct {
+	DiskName string
+	Err      error
+}
+
+func (err DiskReloadError) Error() string {
+	return fmt.Sprintf("reload disk '%s': %v", err.DiskName, err.Err)
+}
+
+// Unwrap returns the underlying error.
+func (err DiskReloadError) Unwrap() error {
+	return err.Err
+}
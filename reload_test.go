@@ -0,0 +1,347 @@
+package godrive_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+type closableDisk struct {
+	*memdisk.Disk
+	bucket string
+	closed atomic.Bool
+}
+
+func (d *closableDisk) Close() error {
+	d.closed.Store(true)
+	return nil
+}
+
+func newReloadConfig() *godrive.AutoWireConfig {
+	cfg := godrive.NewAutoWire()
+	cfg.RegisterProvider("mem", godrive.DiskCreatorFunc(func(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+		bucket, _ := cfg["bucket"].(string)
+		return &closableDisk{Disk: memdisk.New(), bucket: bucket}, nil
+	}))
+	return cfg
+}
+
+func TestWatch(t *testing.T) {
+	for name, opts := range map[string][]godrive.WatchOption{
+		"fsnotify": nil,
+		"polling":  {godrive.Polling(10 * time.Millisecond)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "disks.yml")
+			writeFile(t, path, `
+default: a
+disks:
+  a:
+    provider: mem
+    config:
+      bucket: a
+  b:
+    provider: mem
+    config:
+      bucket: b
+`)
+
+			cfg := newReloadConfig()
+			assert.Nil(t, cfg.Load(path))
+
+			m, err := cfg.NewManager(context.Background())
+			assert.Nil(t, err)
+
+			a, _ := m.Disk("a")
+			b, _ := m.Disk("b")
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events := make(chan godrive.ReloadEvent, 1)
+			go cfg.Watch(ctx, m, path, append(opts, godrive.OnReload(func(evt godrive.ReloadEvent) {
+				events <- evt
+			}))...)
+
+			// give the watcher time to start
+			time.Sleep(50 * time.Millisecond)
+
+			writeFile(t, path, `
+default: c
+disks:
+  a:
+    provider: mem
+    config:
+      bucket: a
+  b:
+    provider: mem
+    config:
+      bucket: b2
+  c:
+    provider: mem
+`)
+
+			var evt godrive.ReloadEvent
+			select {
+			case evt = <-events:
+			case <-time.After(5 * time.Second):
+				t.Fatal("configuration was not reloaded")
+			}
+
+			assert.Nil(t, evt.Err)
+			assert.Equal(t, []string{"c"}, evt.Added)
+			assert.Equal(t, []string{"b"}, evt.Changed)
+			assert.Empty(t, evt.Removed)
+
+			newA, _ := m.Disk("a")
+			assert.Same(t, a, newA)
+			assert.False(t, a.(*closableDisk).closed.Load())
+
+			newB, _ := m.Disk("b")
+			assert.Equal(t, "b2", newB.(*closableDisk).bucket)
+			assert.True(t, b.(*closableDisk).closed.Load())
+
+			assert.Nil(t, m.Put(context.Background(), "file", []byte("content")))
+			c, _ := m.Disk("c")
+			_, err = c.Get(context.Background(), "file")
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestWatch_removeDisk(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "disks:\n  a:\n    provider: mem\n  b:\n    provider: mem\n")
+
+	cfg := newReloadConfig()
+	assert.Nil(t, cfg.Load(path))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	b, _ := m.Disk("b")
+
+	evt := watchChange(t, cfg, m, path, "disks:\n  a:\n    provider: mem\n")
+
+	assert.Nil(t, evt.Err)
+	assert.Equal(t, []string{"b"}, evt.Removed)
+	_, err = m.Disk("b")
+	assert.ErrorIs(t, err, godrive.UnconfiguredDiskError{Name: "b"})
+	assert.True(t, b.(*closableDisk).closed.Load())
+}
+
+func TestWatch_invalidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "disks:\n  a:\n    provider: mem\n")
+
+	cfg := newReloadConfig()
+	assert.Nil(t, cfg.Load(path))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	a, _ := m.Disk("a")
+
+	evt := watchChange(t, cfg, m, path, "disks:\n  a:\n    provider: unknown\n")
+
+	var verr godrive.ValidationError
+	assert.ErrorAs(t, evt.Err, &verr)
+
+	disk, err := m.Disk("a")
+	assert.Nil(t, err)
+	assert.Same(t, a, disk)
+	assert.False(t, a.(*closableDisk).closed.Load())
+}
+
+func TestWatch_secrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: ${vault:a}\n  b:\n    provider: mem\n    config:\n      bucket: ${vault:b}\n")
+
+	var resolved []string
+	cfg := newReloadConfig()
+	godrive.WithSecretResolver("vault", godrive.SecretResolverFunc(func(_ context.Context, ref string) (string, error) {
+		resolved = append(resolved, ref)
+		return "secret-" + ref, nil
+	}))(cfg)
+
+	assert.Nil(t, cfg.Load(path))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	a, _ := m.Disk("a")
+	resolved = nil
+
+	evt := watchChange(t, cfg, m, path, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: ${vault:a}\n  b:\n    provider: mem\n    config:\n      bucket: ${vault:b2}\n")
+
+	// Only the secrets of the changed Disk are resolved.
+	assert.Nil(t, evt.Err)
+	assert.Equal(t, []string{"b"}, evt.Changed)
+	assert.Equal(t, []string{"b2"}, resolved)
+
+	disk, _ := m.Disk("a")
+	assert.Same(t, a, disk)
+	disk, _ = m.Disk("b")
+	assert.Equal(t, "secret-b2", disk.(*closableDisk).bucket)
+}
+
+func TestWatch_lazyInit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "disks:\n  a:\n    provider: mem\n")
+
+	var created atomic.Int32
+	cfg := godrive.NewAutoWire(godrive.LazyInit())
+	cfg.RegisterProvider("mem", godrive.DiskCreatorFunc(func(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+		created.Add(1)
+		bucket, _ := cfg["bucket"].(string)
+		return &closableDisk{Disk: memdisk.New(), bucket: bucket}, nil
+	}))
+
+	assert.Nil(t, cfg.Load(path))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+
+	evt := watchChange(t, cfg, m, path, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: a2\n  b:\n    provider: mem\n")
+
+	assert.Nil(t, evt.Err)
+	assert.Equal(t, []string{"b"}, evt.Added)
+	assert.Equal(t, []string{"a"}, evt.Changed)
+	assert.Equal(t, int32(0), created.Load())
+
+	disk, err := m.Disk("a")
+	assert.Nil(t, err)
+	assert.Equal(t, "a2", disk.(*closableDisk).bucket)
+	assert.Equal(t, int32(1), created.Load())
+}
+
+func TestWatch_runtimeDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "default: a\ndisks:\n  a:\n    provider: mem\n  b:\n    provider: mem\n  c:\n    provider: mem\n")
+
+	cfg := newReloadConfig()
+	assert.Nil(t, cfg.Load(path))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, m.SetDefault("b"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan godrive.ReloadEvent, 1)
+	go cfg.Watch(ctx, m, path, godrive.Polling(10*time.Millisecond), godrive.OnReload(func(evt godrive.ReloadEvent) {
+		events <- evt
+	}))
+	time.Sleep(50 * time.Millisecond)
+
+	// The default Disk of the file is unchanged.
+	writeFile(t, path, "default: a\ndisks:\n  a:\n    provider: mem\n    config:\n      bucket: a2\n  b:\n    provider: mem\n  c:\n    provider: mem\n")
+	assert.Nil(t, waitReload(t, events).Err)
+	assert.Equal(t, "b", defaultDiskName(m))
+
+	// The file changes the default Disk.
+	writeFile(t, path, "default: c\ndisks:\n  a:\n    provider: mem\n  b:\n    provider: mem\n  c:\n    provider: mem\n")
+	assert.Nil(t, waitReload(t, events).Err)
+	assert.Equal(t, "c", defaultDiskName(m))
+}
+
+func TestWatch_include(t *testing.T) {
+	for name, opts := range map[string][]godrive.WatchOption{
+		"fsnotify": nil,
+		"polling":  {godrive.Polling(10 * time.Millisecond)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "disks.yml")
+			writeFile(t, path, "include: shared/disks.yml\ndefault: a\n")
+			included := filepath.Join(dir, "shared", "disks.yml")
+			assert.Nil(t, os.Mkdir(filepath.Dir(included), 0o755))
+			writeFile(t, included, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: a\n")
+
+			cfg := newReloadConfig()
+			assert.Nil(t, cfg.Load(path))
+			m, err := cfg.NewManager(context.Background())
+			assert.Nil(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events := make(chan godrive.ReloadEvent, 1)
+			go cfg.Watch(ctx, m, path, append(opts, godrive.OnReload(func(evt godrive.ReloadEvent) {
+				events <- evt
+			}))...)
+			time.Sleep(50 * time.Millisecond)
+
+			writeFile(t, included, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: a2\n")
+			evt := waitReload(t, events)
+			assert.Nil(t, evt.Err)
+			assert.Equal(t, []string{"a"}, evt.Changed)
+
+			disk, _ := m.Disk("a")
+			assert.Equal(t, "a2", disk.(*closableDisk).bucket)
+		})
+	}
+}
+
+func TestWatch_env(t *testing.T) {
+	t.Setenv("GODRIVE_DISKS_A_CONFIG_BUCKET", "env")
+
+	path := filepath.Join(t.TempDir(), "disks.yml")
+	writeFile(t, path, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: a\n  b:\n    provider: mem\n")
+
+	cfg := newReloadConfig()
+	assert.Nil(t, cfg.Load(path))
+	assert.Nil(t, cfg.LoadEnv("GODRIVE"))
+	m, err := cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+	a, _ := m.Disk("a")
+	assert.Equal(t, "env", a.(*closableDisk).bucket)
+
+	evt := watchChange(t, cfg, m, path, "disks:\n  a:\n    provider: mem\n    config:\n      bucket: a\n  b:\n    provider: mem\n    config:\n      bucket: b2\n")
+
+	// The environment variables still override the file.
+	assert.Nil(t, evt.Err)
+	assert.Equal(t, []string{"b"}, evt.Changed)
+	disk, _ := m.Disk("a")
+	assert.Same(t, a, disk)
+}
+
+func defaultDiskName(m *godrive.Manager) string {
+	for _, info := range m.Disks() {
+		if info.Default {
+			return info.Name
+		}
+	}
+	return ""
+}
+
+func waitReload(t *testing.T, events <-chan godrive.ReloadEvent) godrive.ReloadEvent {
+	select {
+	case evt := <-events:
+		return evt
+	case <-time.After(5 * time.Second):
+		t.Fatal("configuration was not reloaded")
+		return godrive.ReloadEvent{}
+	}
+}
+
+func watchChange(t *testing.T, cfg *godrive.AutoWireConfig, m *godrive.Manager, path, content string) godrive.ReloadEvent {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	events := make(chan godrive.ReloadEvent, 1)
+	go cfg.Watch(ctx, m, path, godrive.Polling(10*time.Millisecond), godrive.OnReload(func(evt godrive.ReloadEvent) {
+		events <- evt
+	}))
+
+	time.Sleep(50 * time.Millisecond)
+	writeFile(t, path, content)
+
+	return waitReload(t, events)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
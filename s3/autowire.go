@@ -15,9 +15,47 @@ const (
 	Provider = "s3"
 )
 
+// Schema is the autowire configuration schema for Amazon S3 disks.
+var Schema = godrive.ConfigSchema{
+	Description: "Amazon S3",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "region",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "AWS region of the bucket.",
+		},
+		{
+			Key:         "bucket",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Name of the storage bucket.",
+		},
+		{
+			Key:         "accessKeyId",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "AWS access key ID.",
+		},
+		{
+			Key:         "secretAccessKey",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "AWS secret access key.",
+		},
+		{
+			Key:         "public",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Make uploaded files publicly accessible.",
+		},
+	},
+}
+
 // Register registeres Amazon S3 as a provider for the disk autowire.
 func Register(cfg *godrive.AutoWireConfig) {
 	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
 }
 
 // NewAutoWire creates a new Amazon S3 disk from an autowire configuration.
@@ -45,7 +83,7 @@ func NewAutoWire(ctx context.Context, cfg map[string]interface{}) (godrive.Disk,
 	accessKeyID, ok := cfg["accessKeyId"].(string)
 	if !ok || accessKeyID == "" {
 		return nil, InvalidConfigValueError{
-			Key:     "bucket",
+			Key:     "accessKeyId",
 			Details: "accessKeyId must be set",
 		}
 	}
@@ -53,7 +91,7 @@ func NewAutoWire(ctx context.Context, cfg map[string]interface{}) (godrive.Disk,
 	secretAccessKey, ok := cfg["secretAccessKey"].(string)
 	if !ok || secretAccessKey == "" {
 		return nil, InvalidConfigValueError{
-			Key:     "bucket",
+			Key:     "secretAccessKey",
 			Details: "secretAccessKey must be set",
 		}
 	}
@@ -6,9 +6,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bounoable/godrive"
 )
 
 // Disk is the Amazon S3 disk.
@@ -104,3 +109,224 @@ func (d *Disk) Delete(ctx context.Context, key string) error {
 func (d *Disk) GetURL(_ context.Context, key string) (string, error) {
 	return fmt.Sprintf("https://%s.s3.amazonaws.com/%s", d.Config.Bucket, key), nil
 }
+
+// GetReader returns a reader for the file with the given key.
+func (d *Disk) GetReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := d.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(d.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.Body, nil
+}
+
+// List returns the keys of all files whose key begins with prefix.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	p := s3.NewListObjectsV2Paginator(d.Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(d.Config.Bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	var keys []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return keys, err
+		}
+
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+	}
+
+	return keys, nil
+}
+
+// Stat returns information about the file with the given key.
+func (d *Disk) Stat(ctx context.Context, key string) (godrive.FileInfo, error) {
+	out, err := d.Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(d.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+
+	return godrive.FileInfo{
+		Path:        key,
+		Size:        out.ContentLength,
+		ContentType: aws.ToString(out.ContentType),
+		ModTime:     aws.ToTime(out.LastModified),
+		Metadata:    out.Metadata,
+	}, nil
+}
+
+// GetSignedURL returns a presigned URL for the file with the given key that expires after expiry.
+func (d *Disk) GetSignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	req, err := s3.NewPresignClient(d.Client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(d.Config.Bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", err
+	}
+
+	return req.URL, nil
+}
+
+// Copy copies the file at src to dst.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	input := &s3.CopyObjectInput{
+		Bucket:     aws.String(d.Config.Bucket),
+		Key:        aws.String(dst),
+		CopySource: aws.String(d.copySource(src)),
+	}
+
+	if d.Config.Public {
+		input.ACL = "public-read"
+	}
+
+	_, err := d.Client.CopyObject(ctx, input)
+
+	return err
+}
+
+func (d *Disk) copySource(key string) string {
+	return url.PathEscape(d.Config.Bucket) + "/" + (&url.URL{Path: key}).EscapedPath()
+}
+
+// GetMetadata returns the custom metadata of the file with the given key.
+func (d *Disk) GetMetadata(ctx context.Context, key string) (map[string]string, error) {
+	info, err := d.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return info.Metadata, nil
+}
+
+// SetMetadata replaces the custom metadata of the file with the given key.
+// S3 objects are immutable, so the object is copied onto itself with the new metadata.
+func (d *Disk) SetMetadata(ctx context.Context, key string, metadata map[string]string) error {
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(d.Config.Bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(d.copySource(key)),
+		Metadata:          metadata,
+		MetadataDirective: types.MetadataDirectiveReplace,
+	}
+
+	if d.Config.Public {
+		input.ACL = "public-read"
+	}
+
+	_, err := d.Client.CopyObject(ctx, input)
+
+	return err
+}
+
+// CheckHealth checks if the bucket exists and is accessible with the credentials of the disk.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	_, err := d.Client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(d.Config.Bucket),
+	})
+	return err
+}
+
+// ListVersions returns the versions and delete markers of the file with the given key, newest first.
+// Noncurrent versions are only kept if versioning is enabled on the bucket.
+func (d *Disk) ListVersions(ctx context.Context, key string) ([]godrive.Version, error) {
+	p := s3.NewListObjectVersionsPaginator(d.Client, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(d.Config.Bucket),
+		Prefix: aws.String(key),
+	})
+
+	var versions []godrive.Version
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return versions, err
+		}
+
+		for _, v := range page.Versions {
+			if aws.ToString(v.Key) != key {
+				continue
+			}
+			versions = append(versions, godrive.Version{
+				ID:      aws.ToString(v.VersionId),
+				Size:    v.Size,
+				ModTime: aws.ToTime(v.LastModified),
+				Latest:  v.IsLatest,
+			})
+		}
+
+		for _, m := range page.DeleteMarkers {
+			if aws.ToString(m.Key) != key {
+				continue
+			}
+			versions = append(versions, godrive.Version{
+				ID:           aws.ToString(m.VersionId),
+				ModTime:      aws.ToTime(m.LastModified),
+				Latest:       m.IsLatest,
+				DeleteMarker: true,
+			})
+		}
+	}
+
+	// LastModified has a precision of one second, so the latest version is preferred on ties,
+	// e.g. for a delete marker of a file that was written in the same second.
+	sort.SliceStable(versions, func(i, j int) bool {
+		if !versions[i].ModTime.Equal(versions[j].ModTime) {
+			return versions[i].ModTime.After(versions[j].ModTime)
+		}
+		return versions[i].Latest && !versions[j].Latest
+	})
+
+	return versions, nil
+}
+
+// GetVersion retrieves the version of the file with the given key.
+func (d *Disk) GetVersion(ctx context.Context, key, versionID string) ([]byte, error) {
+	obj, err := d.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket:    aws.String(d.Config.Bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Body.Close()
+
+	return io.ReadAll(obj.Body)
+}
+
+// RestoreVersion copies the version of the file with the given key onto the key,
+// which creates a new current version.
+func (d *Disk) RestoreVersion(ctx context.Context, key, versionID string) error {
+	input := &s3.CopyObjectInput{
+		Bucket:     aws.String(d.Config.Bucket),
+		Key:        aws.String(key),
+		CopySource: aws.String(d.copySource(key) + "?versionId=" + url.QueryEscape(versionID)),
+	}
+
+	if d.Config.Public {
+		input.ACL = "public-read"
+	}
+
+	_, err := d.Client.CopyObject(ctx, input)
+
+	return err
+}
+
+// DeleteVersion permanently deletes the version or delete marker of the file with the given key.
+// Deleting a delete marker restores the previous version.
+func (d *Disk) DeleteVersion(ctx context.Context, key, versionID string) error {
+	_, err := d.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket:    aws.String(d.Config.Bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionID),
+	})
+	return err
+}
@@ -0,0 +1,374 @@
+package godrive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ConfigType is the type of a configuration value.
+type ConfigType string
+
+const (
+	// TypeString is a string value.
+	TypeString = ConfigType("string")
+	// TypeBool is a boolean value.
+	TypeBool = ConfigType("boolean")
+	// TypeInt is an integer value.
+	TypeInt = ConfigType("integer")
+	// TypeNumber is a numeric value.
+	TypeNumber = ConfigType("number")
+	// TypeMap is a map of values.
+	TypeMap = ConfigType("object")
+	// TypeList is a list of values.
+	TypeList = ConfigType("array")
+)
+
+// ConfigSchema describes the configuration of a storage provider.
+type ConfigSchema struct {
+	Description string
+	Fields      []ConfigField
+}
+
+// ConfigField describes a single configuration value of a storage provider.
+type ConfigField struct {
+	Key         string
+	Type        ConfigType
+	Required    bool
+	Default     interface{}
+	Description string
+}
+
+// Field returns the field with the given key.
+func (s ConfigSchema) Field(key string) (ConfigField, bool) {
+	for _, field := range s.Fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return ConfigField{}, false
+}
+
+// Validate validates cfg against the schema and returns all errors.
+// It reports missing required keys, values with a wrong type and unknown keys.
+func (s ConfigSchema) Validate(cfg map[string]interface{}) []error {
+	var errs []error
+
+	for _, field := range s.Fields {
+		val, ok := cfg[field.Key]
+		if !ok || val == nil {
+			if field.Required {
+				errs = append(errs, ConfigKeyError{
+					Key:     field.Key,
+					Details: "required key is missing",
+				})
+			}
+			continue
+		}
+
+		// Secrets are resolved when the disk is created, so their type is unknown.
+		if s, ok := val.(string); ok && hasSecretPlaceholder(s) {
+			continue
+		}
+
+		if !field.Type.matches(val) {
+			errs = append(errs, ConfigKeyError{
+				Key:     field.Key,
+				Details: fmt.Sprintf("must be of type '%s' but is a '%T'", field.Type, val),
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(cfg) {
+		if _, ok := s.Field(key); !ok {
+			errs = append(errs, ConfigKeyError{
+				Key:     key,
+				Details: "unknown key",
+			})
+		}
+	}
+
+	return errs
+}
+
+// WithDefaults returns a copy of cfg where missing keys are filled with the defaults of the schema.
+func (s ConfigSchema) WithDefaults(cfg map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(cfg))
+	for key, val := range cfg {
+		out[key] = val
+	}
+
+	for _, field := range s.Fields {
+		if _, ok := out[field.Key]; !ok && field.Default != nil {
+			out[field.Key] = field.Default
+		}
+	}
+
+	return out
+}
+
+// JSONSchema returns the JSON Schema of the configuration.
+func (s ConfigSchema) JSONSchema() map[string]interface{} {
+	props := make(map[string]interface{}, len(s.Fields))
+	var required []string
+
+	for _, field := range s.Fields {
+		prop := map[string]interface{}{"type": string(field.Type)}
+		if field.Description != "" {
+			prop["description"] = field.Description
+		}
+		if field.Default != nil {
+			prop["default"] = field.Default
+		}
+		props[field.Key] = prop
+
+		if field.Required {
+			required = append(required, field.Key)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if s.Description != "" {
+		schema["description"] = s.Description
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+func (t ConfigType) matches(val interface{}) bool {
+	switch t {
+	case TypeString:
+		_, ok := val.(string)
+		return ok
+	case TypeBool:
+		_, ok := val.(bool)
+		return ok
+	case TypeInt:
+		switch v := val.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+		return false
+	case TypeNumber:
+		switch val.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return true
+		}
+		return false
+	case TypeMap:
+		_, ok := val.(map[string]interface{})
+		return ok
+	case TypeList:
+		_, ok := val.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// ConfigKeyError means a configuration value is missing, has a wrong type or is unknown.
+type ConfigKeyError struct {
+	DiskName string
+	Key      string
+	Details  string
+}
+
+func (err ConfigKeyError) Error() string {
+	if err.DiskName == "" {
+		return fmt.Sprintf("invalid config key '%s': %s", err.Key, err.Details)
+	}
+	return fmt.Sprintf("invalid config key '%s' for disk '%s': %s", err.Key, err.DiskName, err.Details)
+}
+
+// RegisterSchema registers the configuration schema of a storage provider.
+// Configurations of disks that use the provider are validated against the schema
+// and missing values are filled with the defaults of the schema.
+func (cfg *AutoWireConfig) RegisterSchema(provider string, schema ConfigSchema) {
+	cfg.Schemas[provider] = schema
+}
+
+// Validate validates the configuration and reports all errors at once.
+// It checks that all providers and middleware are registered, that the default disk exists and validates
+// the disk configurations against the schemas of their providers.
+// If the configuration is invalid, it returns a ValidationError.
+func (cfg *AutoWireConfig) Validate() error {
+	var errs []error
+
+	for _, diskname := range sortedKeys(cfg.Disks) {
+		diskcfg := cfg.Disks[diskname]
+
+		for _, scheme := range secretSchemes(diskcfg.Config) {
+			if _, ok := cfg.SecretResolvers[scheme]; !ok {
+				errs = append(errs, ConfigKeyError{
+					DiskName: diskname,
+					Key:      "config",
+					Details:  UnregisteredSecretResolverError{Scheme: scheme}.Error(),
+				})
+			}
+		}
+
+		if _, ok := cfg.Creators[diskcfg.Provider]; !ok {
+			errs = append(errs, UnregisteredProviderError{Provider: diskcfg.Provider})
+			continue
+		}
+
+		schema, ok := cfg.Schemas[diskcfg.Provider]
+		if !ok {
+			continue
+		}
+
+		for _, err := range schema.Validate(diskcfg.Config) {
+			var keyerr ConfigKeyError
+			if errors.As(err, &keyerr) {
+				keyerr.DiskName = diskname
+				err = keyerr
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if cfg.DefaultDiskName != "" {
+		if _, ok := cfg.Disks[cfg.DefaultDiskName]; !ok {
+			errs = append(errs, UnknownDefaultDiskError{Name: cfg.DefaultDiskName})
+		}
+	}
+
+	for _, mwcfg := range cfg.Middleware {
+		if _, ok := cfg.MiddlewareCreators[mwcfg.Name]; !ok {
+			errs = append(errs, UnregisteredMiddlewareError{Name: mwcfg.Name})
+		}
+	}
+
+	if len(errs) > 0 {
+		return ValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// UnknownDefaultDiskError means the configured default disk does not exist.
+type UnknownDefaultDiskError struct {
+	Name string
+}
+
+func (err UnknownDefaultDiskError) Error() string {
+	return fmt.Sprintf("default disk '%s' is not configured", err.Name)
+}
+
+// ValidationError contains all errors of an invalid autowire configuration.
+type ValidationError struct {
+	Errors []error
+}
+
+func (err ValidationError) Error() string {
+	msgs := make([]string, len(err.Errors))
+	for i, e := range err.Errors {
+		msgs[i] = e.Error()
+	}
+	return fmt.Sprintf("invalid disk configuration:\n  - %s", strings.Join(msgs, "\n  - "))
+}
+
+// Unwrap returns the errors.
+func (err ValidationError) Unwrap() []error {
+	return err.Errors
+}
+
+// JSONSchema returns the JSON Schema for configuration files with the registered providers and middleware.
+// Use it to enable validation and autocompletion in editors.
+func (cfg *AutoWireConfig) JSONSchema() ([]byte, error) {
+	providers := sortedKeys(cfg.Creators)
+
+	var conditions []interface{}
+	for _, provider := range providers {
+		schema, ok := cfg.Schemas[provider]
+		if !ok {
+			continue
+		}
+
+		conditions = append(conditions, map[string]interface{}{
+			"if": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"provider": map[string]interface{}{"const": provider},
+				},
+			},
+			"then": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"config": schema.JSONSchema(),
+				},
+			},
+		})
+	}
+
+	disk := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"provider"},
+		"properties": map[string]interface{}{
+			"provider": map[string]interface{}{
+				"type":        "string",
+				"description": "The storage provider.",
+				"enum":        providers,
+			},
+			"config": map[string]interface{}{
+				"type":        "object",
+				"description": "Configuration for the storage provider.",
+			},
+		},
+	}
+	if len(conditions) > 0 {
+		disk["allOf"] = conditions
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"default": map[string]interface{}{
+				"type":        "string",
+				"description": "Name of the default disk.",
+			},
+			"disks": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": disk,
+			},
+			"middleware": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type":     "object",
+					"required": []string{"name"},
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type": "string",
+							"enum": sortedKeys(cfg.MiddlewareCreators),
+						},
+						"disks": map[string]interface{}{
+							"type":  "array",
+							"items": map[string]interface{}{"type": "string"},
+						},
+						"config": map[string]interface{}{"type": "object"},
+					},
+				},
+			},
+		},
+	}, "", "  ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,107 @@
+package godrive_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/gcs"
+	"github.com/bounoable/godrive/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoWireConfig_Validate(t *testing.T) {
+	cfg := godrive.NewAutoWire(gcs.Register, s3.Register)
+
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  googlecloud:
+    provider: gcs
+    config:
+      serviceAccount: /path/to/service/account.json
+      bucket: uploads
+      urlTemplate: https://storage.customdomain.test/{{ .Bucket }}/{{ .Path }}
+  amazonaws:
+    provider: s3
+    config:
+      region: us-east-2
+      accessKeyId: some-access-key-id
+      secretAccessKey: some-secret-access-key
+      public: "yes"
+      acl: private
+  other:
+    provider: other
+`))
+	assert.Nil(t, err)
+
+	err = cfg.Validate()
+
+	var verr godrive.ValidationError
+	assert.True(t, errors.As(err, &verr))
+	assert.Equal(t, []error{
+		godrive.ConfigKeyError{DiskName: "amazonaws", Key: "bucket", Details: "required key is missing"},
+		godrive.ConfigKeyError{DiskName: "amazonaws", Key: "public", Details: "must be of type 'boolean' but is a 'string'"},
+		godrive.ConfigKeyError{DiskName: "amazonaws", Key: "acl", Details: "unknown key"},
+		godrive.UnregisteredProviderError{Provider: "other"},
+	}, verr.Errors)
+	assert.ErrorIs(t, err, godrive.UnregisteredProviderError{Provider: "other"})
+
+	_, err = cfg.NewManager(context.Background())
+	assert.True(t, errors.As(err, &godrive.ValidationError{}))
+}
+
+func TestConfigSchema_WithDefaults(t *testing.T) {
+	cfg := gcs.Schema.WithDefaults(map[string]interface{}{
+		"bucket": "uploads",
+		"public": true,
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"bucket":      "uploads",
+		"public":      true,
+		"urlTemplate": gcs.DefaultURLTemplate,
+	}, cfg)
+}
+
+func TestAutoWireConfig_JSONSchema(t *testing.T) {
+	cfg := godrive.NewAutoWire(gcs.Register, s3.Register)
+
+	b, err := cfg.JSONSchema()
+	assert.Nil(t, err)
+
+	var schema struct {
+		Properties struct {
+			Disks struct {
+				AdditionalProperties struct {
+					Properties struct {
+						Provider struct {
+							Enum []string
+						}
+					}
+					AllOf []struct {
+						Then struct {
+							Properties struct {
+								Config struct {
+									Required   []string
+									Properties map[string]interface{}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	assert.Nil(t, json.Unmarshal(b, &schema))
+
+	disk := schema.Properties.Disks.AdditionalProperties
+	assert.Equal(t, []string{"gcs", "s3"}, disk.Properties.Provider.Enum)
+	assert.Len(t, disk.AllOf, 2)
+
+	gcscfg := disk.AllOf[0].Then.Properties.Config
+	assert.Equal(t, []string{"serviceAccount", "bucket"}, gcscfg.Required)
+	assert.Contains(t, gcscfg.Properties, "urlTemplate")
+}
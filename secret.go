@@ -0,0 +1,80 @@
+package godrive
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	// EnvSecrets resolves ${env:VAR} placeholders to the value of the environment variable VAR.
+	// In contrast to ${VAR}, it returns an error if the variable is unset.
+	EnvSecrets = SecretResolverFunc(func(_ context.Context, ref string) (string, error) {
+		val, ok := os.LookupEnv(ref)
+		if !ok {
+			return "", MissingEnvError{Var: ref}
+		}
+		return val, nil
+	})
+
+	// FileSecrets resolves ${file:/path/to/file} placeholders to the content of the
+	// file without trailing newlines (e.g. Docker or Kubernetes secrets).
+	FileSecrets = SecretResolverFunc(func(_ context.Context, ref string) (string, error) {
+		b, err := os.ReadFile(ref)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	})
+)
+
+// SecretResolver resolves secret placeholders in disk configurations.
+//
+// A resolver is registered for a scheme and referenced in the configuration as ${scheme:ref},
+// e.g. ${vault:kv/storage#secret}. Secrets are resolved by (*AutoWireConfig).NewManager right
+// before a disk is created, so they are not kept in DiskCreatorConfig.Config.
+type SecretResolver interface {
+	ResolveSecret(ctx context.Context, ref string) (string, error)
+}
+
+// SecretResolverFunc resolves secret placeholders.
+type SecretResolverFunc func(ctx context.Context, ref string) (string, error)
+
+// ResolveSecret resolves the secret with the given reference.
+func (fn SecretResolverFunc) ResolveSecret(ctx context.Context, ref string) (string, error) {
+	return fn(ctx, ref)
+}
+
+// StaticSecrets returns a SecretResolver that resolves secrets from a map.
+// Use it as a local stand-in for a secrets manager during development and in tests.
+func StaticSecrets(secrets map[string]string) SecretResolver {
+	return SecretResolverFunc(func(_ context.Context, ref string) (string, error) {
+		secret, ok := secrets[ref]
+		if !ok {
+			return "", fmt.Errorf("secret '%s' not found", ref)
+		}
+		return secret, nil
+	})
+}
+
+// WithSecretResolver returns an AutoWireOption that registers a SecretResolver for scheme.
+func WithSecretResolver(scheme string, resolver SecretResolver) AutoWireOption {
+	return func(cfg *AutoWireConfig) {
+		cfg.RegisterSecretResolver(scheme, resolver)
+	}
+}
+
+// RegisterSecretResolver registers a SecretResolver for scheme.
+func (cfg *AutoWireConfig) RegisterSecretResolver(scheme string, resolver SecretResolver) {
+	cfg.SecretResolvers[scheme] = resolver
+}
+
+// UnregisteredSecretResolverError means the configuration references a secret scheme without a registered SecretResolver.
+type UnregisteredSecretResolverError struct {
+	Scheme string
+}
+
+func (err UnregisteredSecretResolverError) Error() string {
+	return fmt.Sprintf("unregistered secret resolver '%s'", err.Scheme)
+}
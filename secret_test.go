@@ -0,0 +1,86 @@
+package godrive_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretResolver(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "secret")
+	assert.Nil(t, os.WriteFile(secret, []byte("some-secret-access-key\n"), 0600))
+	t.Setenv("ACCESS_KEY_ID", "some-access-key-id")
+
+	var created map[string]interface{}
+	cfg := godrive.NewAutoWire(
+		godrive.WithSecretResolver("vault", godrive.StaticSecrets(map[string]string{
+			"kv/storage#token":   "some-token",
+			"kv/storage#retries": "3",
+		})),
+	)
+	cfg.RegisterProvider("test", godrive.DiskCreatorFunc(func(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+		created = cfg
+		return memdisk.New(), nil
+	}))
+
+	err := cfg.LoadYAMLReader(strings.NewReader(`
+disks:
+  main:
+    provider: test
+    config:
+      accessKeyId: ${env:ACCESS_KEY_ID}
+      secretAccessKey: ${file:` + secret + `}
+      auth:
+        token: Bearer ${vault:kv/storage#token}
+      retries: ${vault:kv/storage#retries}
+      escaped: $${vault:kv/storage#token}
+`))
+	assert.Nil(t, err)
+
+	raw := cfg.Disks["main"].Config
+	assert.Equal(t, "${file:"+secret+"}", raw["secretAccessKey"])
+	assert.Equal(t, map[string]interface{}{"token": "Bearer ${vault:kv/storage#token}"}, raw["auth"])
+
+	_, err = cfg.NewManager(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"accessKeyId":     "some-access-key-id",
+		"secretAccessKey": "some-secret-access-key",
+		"auth":            map[string]interface{}{"token": "Bearer some-token"},
+		"retries":         "3",
+		"escaped":         "${vault:kv/storage#token}",
+	}, created)
+
+	// the configuration still only contains the references
+	assert.Equal(t, "${file:"+secret+"}", cfg.Disks["main"].Config["secretAccessKey"])
+	assert.Equal(t, map[string]interface{}{"token": "Bearer ${vault:kv/storage#token}"}, cfg.Disks["main"].Config["auth"])
+}
+
+func TestSecretResolver_errors(t *testing.T) {
+	cfg := godrive.NewAutoWire()
+	cfg.RegisterProvider("test", godrive.DiskCreatorFunc(func(context.Context, map[string]interface{}) (godrive.Disk, error) {
+		return memdisk.New(), nil
+	}))
+
+	cfg.Configure("main", "test", map[string]interface{}{"token": "${vault:kv/storage#token}"})
+	err := cfg.Validate()
+	assert.True(t, errors.As(err, &godrive.ValidationError{}))
+	assert.Contains(t, err.Error(), "unregistered secret resolver 'vault'")
+
+	cfg.Configure("main", "test", map[string]interface{}{"key": "${file:/does/not/exist}"})
+	_, err = cfg.NewManager(context.Background())
+	assert.True(t, errors.As(err, &godrive.PlaceholderError{}))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	cfg.Configure("main", "test", map[string]interface{}{"key": "${env:GODRIVE_UNSET_VAR}"})
+	_, err = cfg.NewManager(context.Background())
+	assert.ErrorIs(t, err, godrive.MissingEnvError{Var: "GODRIVE_UNSET_VAR"})
+}
@@ -0,0 +1,244 @@
+package sftp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/bounoable/godrive"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// Provider is the provider name for SFTP.
+	Provider = "sftp"
+)
+
+// Schema is the autowire configuration schema for SFTP disks.
+var Schema = godrive.ConfigSchema{
+	Description: "SFTP",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "host",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Host name of the SSH server.",
+		},
+		{
+			Key:         "port",
+			Type:        godrive.TypeInt,
+			Default:     22,
+			Description: "Port of the SSH server.",
+		},
+		{
+			Key:         "user",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "SSH user.",
+		},
+		{
+			Key:         "password",
+			Type:        godrive.TypeString,
+			Description: "Password of the user.",
+		},
+		{
+			Key:         "privateKey",
+			Type:        godrive.TypeString,
+			Description: "PEM encoded private key of the user.",
+		},
+		{
+			Key:         "privateKeyFile",
+			Type:        godrive.TypeString,
+			Description: "Path to the private key of the user.",
+		},
+		{
+			Key:         "passphrase",
+			Type:        godrive.TypeString,
+			Description: "Passphrase of the private key.",
+		},
+		{
+			Key:         "knownHosts",
+			Type:        godrive.TypeString,
+			Description: "Path to the known_hosts file that is used to verify the host key of the server.",
+		},
+		{
+			Key:         "insecureIgnoreHostKey",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Don't verify the host key of the server. Use only for testing.",
+		},
+		{
+			Key:         "root",
+			Type:        godrive.TypeString,
+			Description: "Directory on the server that contains the files of the disk.",
+		},
+		{
+			Key:         "poolSize",
+			Type:        godrive.TypeInt,
+			Default:     DefaultPoolSize,
+			Description: "Maximum number of open connections.",
+		},
+	},
+}
+
+// Register registers SFTP as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new SFTP disk from an autowire configuration.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	host, ok := cfg["host"].(string)
+	if !ok || host == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "host",
+			Details: "host must be set",
+		}
+	}
+
+	port := 22
+	if rport, ok := cfg["port"]; ok {
+		if port, ok = rport.(int); !ok {
+			return nil, InvalidConfigValueError{
+				Key:     "port",
+				Details: fmt.Sprintf("port must be an integer but it is '%T'", rport),
+			}
+		}
+	}
+
+	user, ok := cfg["user"].(string)
+	if !ok || user == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "user",
+			Details: "user must be set",
+		}
+	}
+
+	auth, err := authMethods(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := hostKeyCallback(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []Option
+
+	if rroot, ok := cfg["root"]; ok {
+		root, ok := rroot.(string)
+		if !ok {
+			return nil, InvalidConfigValueError{
+				Key:     "root",
+				Details: fmt.Sprintf("root must be a string but it is '%T'", rroot),
+			}
+		}
+		options = append(options, Root(root))
+	}
+
+	if rsize, ok := cfg["poolSize"]; ok {
+		size, ok := rsize.(int)
+		if !ok || size < 1 {
+			return nil, InvalidConfigValueError{
+				Key:     "poolSize",
+				Details: fmt.Sprintf("pool size must be a positive integer but it is '%v'", rsize),
+			}
+		}
+		options = append(options, PoolSize(size))
+	}
+
+	return NewDisk(net.JoinHostPort(host, strconv.Itoa(port)), &ssh.ClientConfig{
+		User:            user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, options...), nil
+}
+
+func authMethods(cfg map[string]interface{}) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+
+	key, _ := cfg["privateKey"].(string)
+	if file, ok := cfg["privateKeyFile"].(string); ok && file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "privateKeyFile",
+				Details: fmt.Sprintf("read private key: %v", err),
+			}
+		}
+		key = string(b)
+	}
+
+	if key != "" {
+		var signer ssh.Signer
+		var err error
+		if passphrase, _ := cfg["passphrase"].(string); passphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(passphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(key))
+		}
+		if err != nil {
+			return nil, InvalidConfigValueError{
+				Key:     "privateKey",
+				Details: fmt.Sprintf("parse private key: %v", err),
+			}
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if password, ok := cfg["password"].(string); ok && password != "" {
+		methods = append(methods, ssh.Password(password))
+	}
+
+	if len(methods) == 0 {
+		return nil, InvalidConfigValueError{
+			Key:     "password",
+			Details: "either password, privateKey or privateKeyFile must be set",
+		}
+	}
+
+	return methods, nil
+}
+
+func hostKeyCallback(cfg map[string]interface{}) (ssh.HostKeyCallback, error) {
+	if insecure, _ := cfg["insecureIgnoreHostKey"].(bool); insecure {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	file, ok := cfg["knownHosts"].(string)
+	if !ok || file == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "knownHosts",
+			Details: "known hosts file must be set to verify the host key",
+		}
+	}
+
+	callback, err := knownhosts.New(file)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "knownHosts",
+			Details: err.Error(),
+		}
+	}
+
+	return callback, nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
@@ -0,0 +1,320 @@
+// Package sftp provides the SFTP disk implementation.
+package sftp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/bounoable/godrive"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// DefaultPoolSize is the default maximum number of open connections of a disk.
+	DefaultPoolSize = 4
+)
+
+// Disk is the SFTP disk.
+// It keeps a pool of SSH connections and reconnects automatically if a connection is lost.
+type Disk struct {
+	Config Config
+	pool   *pool
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// Addr is the address of the SSH server (host:port).
+	Addr string
+	// SSH is the configuration of the SSH connections.
+	SSH *ssh.ClientConfig
+	// Root is the directory on the server that contains the files of the disk.
+	Root     string
+	PoolSize int
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// Root sets the directory on the server that contains the files of the disk.
+// Paths are resolved relative to the directory and cannot leave it.
+func Root(dir string) Option {
+	return func(cfg *Config) {
+		cfg.Root = dir
+	}
+}
+
+// PoolSize sets the maximum number of open connections.
+func PoolSize(size int) Option {
+	return func(cfg *Config) {
+		cfg.PoolSize = size
+	}
+}
+
+// NewDisk creates a new SFTP disk for the server at addr.
+// Connections are established when needed.
+func NewDisk(addr string, sshConfig *ssh.ClientConfig, options ...Option) *Disk {
+	if sshConfig == nil {
+		panic("invalid ssh client config")
+	}
+
+	cfg := Config{
+		Addr:     addr,
+		SSH:      sshConfig,
+		PoolSize: DefaultPoolSize,
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	if cfg.PoolSize < 1 {
+		cfg.PoolSize = 1
+	}
+
+	d := &Disk{Config: cfg}
+	d.pool = newPool(cfg.PoolSize, d.dial)
+
+	return d
+}
+
+func (d *Disk) dial(ctx context.Context) (*conn, error) {
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", d.Config.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(netConn, d.Config.Addr, d.Config.SSH)
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, err
+	}
+
+	return &conn{ssh: sshClient, sftp: client}, nil
+}
+
+// do calls fn with a pooled connection.
+// If retry is true and the connection was lost, fn is called again with a new connection.
+func (d *Disk) do(ctx context.Context, retry bool, fn func(*sftp.Client) error) error {
+	for {
+		c, err := d.pool.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = fn(c.sftp)
+		d.pool.put(c, err)
+
+		if !retry || !isConnError(err) {
+			return err
+		}
+		retry = false
+	}
+}
+
+// path returns the path on the server for a path of the disk.
+// Without a root directory, paths are relative to the working directory of the SFTP session.
+func (d *Disk) path(p string) string {
+	root := d.Config.Root
+	if root == "" {
+		root = "."
+	}
+	return path.Join(root, path.Join("/", p))
+}
+
+// rel returns the path of the disk for a path on the server.
+func (d *Disk) rel(p string) string {
+	root := d.path("")
+	if root == "." {
+		return p
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(p, root), "/")
+}
+
+// Put writes b to the file at the given path.
+// Missing directories are created.
+func (d *Disk) Put(ctx context.Context, p string, b []byte) error {
+	return d.do(ctx, true, func(client *sftp.Client) error {
+		return writeFile(client, d.path(p), b)
+	})
+}
+
+// PutReader writes r to the file at the given path.
+// Missing directories are created.
+func (d *Disk) PutReader(ctx context.Context, p string, r io.Reader) error {
+	return d.do(ctx, false, func(client *sftp.Client) error {
+		return writeFile(client, d.path(p), r)
+	})
+}
+
+func writeFile(client *sftp.Client, p string, content interface{}) error {
+	if err := client.MkdirAll(path.Dir(p)); err != nil {
+		return err
+	}
+
+	f, err := client.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+
+	switch c := content.(type) {
+	case []byte:
+		_, err = f.Write(c)
+	case io.Reader:
+		_, err = f.ReadFrom(c)
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// Get retrieves the file at the given path.
+func (d *Disk) Get(ctx context.Context, p string) ([]byte, error) {
+	var b []byte
+	err := d.do(ctx, true, func(client *sftp.Client) error {
+		f, err := client.Open(d.path(p))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		b, err = io.ReadAll(f)
+		return err
+	})
+	return b, err
+}
+
+// GetReader returns a reader for the file at the given path.
+// The connection is returned to the pool when the reader is closed.
+func (d *Disk) GetReader(ctx context.Context, p string) (io.ReadCloser, error) {
+	c, err := d.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := c.sftp.Open(d.path(p))
+	if err != nil {
+		d.pool.put(c, err)
+		return nil, err
+	}
+
+	return &pooledReader{File: f, pool: d.pool, conn: c}, nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(ctx context.Context, p string) error {
+	return d.do(ctx, true, func(client *sftp.Client) error {
+		return client.Remove(d.path(p))
+	})
+}
+
+// List returns the paths of all files whose path begins with prefix.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	dir := path.Dir("/" + prefix)
+	if strings.HasSuffix(prefix, "/") {
+		dir = path.Join("/", prefix)
+	}
+
+	var paths []string
+	err := d.do(ctx, true, func(client *sftp.Client) error {
+		paths = nil
+
+		walker := client.Walk(d.path(dir))
+		for walker.Step() {
+			if err := walker.Err(); err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return err
+			}
+
+			if walker.Stat().IsDir() {
+				continue
+			}
+
+			if rel := d.rel(walker.Path()); strings.HasPrefix(rel, prefix) {
+				paths = append(paths, rel)
+			}
+		}
+		return nil
+	})
+
+	return paths, err
+}
+
+// Stat returns information about the file at the given path.
+func (d *Disk) Stat(ctx context.Context, p string) (godrive.FileInfo, error) {
+	var info godrive.FileInfo
+	err := d.do(ctx, true, func(client *sftp.Client) error {
+		stat, err := client.Stat(d.path(p))
+		if err != nil {
+			return err
+		}
+
+		info = godrive.FileInfo{
+			Path:    p,
+			Size:    stat.Size(),
+			ModTime: stat.ModTime(),
+		}
+		return nil
+	})
+	return info, err
+}
+
+// CheckHealth checks if the server is reachable and the root directory exists.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	return d.do(ctx, true, func(client *sftp.Client) error {
+		_, err := client.Stat(d.path(""))
+		return err
+	})
+}
+
+// Close closes all idle connections.
+// Connections that are in use are closed when they are released.
+func (d *Disk) Close() error {
+	return d.pool.close()
+}
+
+// isConnError determines if err means that the connection is unusable.
+func isConnError(err error) bool {
+	return errors.Is(err, sftp.ErrSSHFxConnectionLost) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed)
+}
+
+type pooledReader struct {
+	*sftp.File
+	pool *pool
+	conn *conn
+	once sync.Once
+}
+
+// Close closes the file and releases the connection. Only the first call has an effect.
+func (r *pooledReader) Close() error {
+	var err error
+	r.once.Do(func() {
+		err = r.File.Close()
+		r.pool.put(r.conn, err)
+	})
+	return err
+}
@@ -0,0 +1,212 @@
+package sftp_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/sftp"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDisk(t *testing.T, srv *testServer, config map[string]interface{}) *sftp.Disk {
+	host, rport, _ := net.SplitHostPort(srv.Addr)
+	port, _ := strconv.Atoi(rport)
+	cfg := map[string]interface{}{
+		"host":       host,
+		"port":       port,
+		"user":       testUser,
+		"password":   testPassword,
+		"knownHosts": srv.KnownHosts,
+		"root":       srv.Dir,
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := sftp.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { disk.(*sftp.Disk).Close() })
+
+	return disk.(*sftp.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/sub/file2.txt", strings.NewReader("content2")))
+	assert.Nil(t, disk.Put(ctx, "other.txt", []byte("other")))
+
+	b, err := os.ReadFile(filepath.Join(srv.Dir, "dir", "file1.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	b, err = disk.Get(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	r, err := disk.GetReader(ctx, "dir/sub/file2.txt")
+	assert.Nil(t, err)
+	b, _ = io.ReadAll(r)
+	assert.Nil(t, r.Close())
+	assert.Equal(t, []byte("content2"), b)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+	paths, err = disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"dir/file1.txt", "dir/sub/file2.txt", "other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "oth")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "missing/")
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+
+	info, err := disk.Stat(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+
+	assert.Nil(t, disk.Delete(ctx, "other.txt"))
+	_, err = disk.Get(ctx, "other.txt")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_rootCannotBeLeft(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, nil)
+
+	assert.Nil(t, disk.Put(context.Background(), "../../escape.txt", []byte("content")))
+
+	_, err := os.Stat(filepath.Join(srv.Dir, "escape.txt"))
+	assert.Nil(t, err)
+}
+
+func TestDisk_privateKey(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{
+		"password":   "",
+		"privateKey": srv.PrivateKey,
+	})
+
+	assert.Nil(t, disk.Put(context.Background(), "file.txt", []byte("content")))
+}
+
+func TestDisk_unknownHostKey(t *testing.T) {
+	srv := newTestServer(t)
+	other := newTestServer(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{"knownHosts": other.KnownHosts})
+
+	err := disk.Put(context.Background(), "file.txt", []byte("content"))
+	assert.ErrorContains(t, err, "knownhosts")
+}
+
+func TestDisk_pool(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{"poolSize": 2})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, srv.dialCount(), 2)
+}
+
+func TestDisk_GetReader_closeTwice(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, map[string]interface{}{"poolSize": 1})
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+
+	r, err := disk.GetReader(ctx, "file.txt")
+	assert.Nil(t, err)
+	_, err = io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+
+	// A second Close must not release the connection again.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Close()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("second Close blocks")
+	}
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+	assert.Equal(t, 1, srv.dialCount())
+}
+
+func TestDisk_reconnect(t *testing.T) {
+	srv := newTestServer(t)
+	disk := newTestDisk(t, srv, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	srv.dropConnections()
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+	assert.Equal(t, 2, srv.dialCount())
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := sftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test", "user": "bob"})
+	assert.Equal(t, sftp.InvalidConfigValueError{
+		Key:     "password",
+		Details: "either password, privateKey or privateKeyFile must be set",
+	}, err)
+
+	_, err = sftp.NewAutoWire(ctx, map[string]interface{}{"host": "example.test", "user": "bob", "password": "pw"})
+	assert.Equal(t, sftp.InvalidConfigValueError{
+		Key:     "knownHosts",
+		Details: "known hosts file must be set to verify the host key",
+	}, err)
+
+	disk, err := sftp.NewAutoWire(ctx, map[string]interface{}{
+		"host":                  "example.test",
+		"user":                  "bob",
+		"password":              "pw",
+		"insecureIgnoreHostKey": true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "example.test:22", disk.(*sftp.Disk).Config.Addr)
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapStreaming|godrive.CapListing|godrive.CapStat|godrive.CapHealth))
+}
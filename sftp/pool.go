@@ -0,0 +1,100 @@
+package sftp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrClosed is returned by the operations of a closed Disk.
+var ErrClosed = errors.New("sftp disk closed")
+
+type conn struct {
+	ssh  *ssh.Client
+	sftp *sftp.Client
+}
+
+func (c *conn) close() {
+	c.sftp.Close()
+	c.ssh.Close()
+}
+
+// pool limits the number of open connections and reuses idle connections.
+type pool struct {
+	dial func(context.Context) (*conn, error)
+	sem  chan struct{}
+
+	mux    sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+func newPool(size int, dial func(context.Context) (*conn, error)) *pool {
+	return &pool{
+		dial: dial,
+		sem:  make(chan struct{}, size),
+	}
+}
+
+// get returns an idle connection or dials a new one.
+// It blocks until a connection is available or ctx is canceled.
+func (p *pool) get(ctx context.Context) (*conn, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		<-p.sem
+		return nil, ErrClosed
+	}
+	if n := len(p.idle); n > 0 {
+		c := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mux.Unlock()
+		return c, nil
+	}
+	p.mux.Unlock()
+
+	c, err := p.dial(ctx)
+	if err != nil {
+		<-p.sem
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// put releases a connection. If err means the connection is unusable, the connection is closed.
+func (p *pool) put(c *conn, err error) {
+	defer func() { <-p.sem }()
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.closed || isConnError(err) {
+		c.close()
+		return
+	}
+
+	p.idle = append(p.idle, c)
+}
+
+func (p *pool) close() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.closed = true
+	for _, c := range p.idle {
+		c.close()
+	}
+	p.idle = nil
+
+	return nil
+}
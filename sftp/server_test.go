@@ -0,0 +1,172 @@
+package sftp_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// testServer is an in-process SSH server with the SFTP subsystem.
+type testServer struct {
+	Addr       string
+	Dir        string
+	KnownHosts string
+	PrivateKey string
+
+	mux   sync.Mutex
+	conns []net.Conn
+	dials int
+}
+
+const (
+	testUser     = "godrive"
+	testPassword = "secret"
+)
+
+func newTestServer(t *testing.T) *testServer {
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPub, userKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userSSHPub, err := ssh.NewPublicKey(userPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == testUser && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, ssh.ErrNoAuth
+		},
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if meta.User() == testUser && string(key.Marshal()) == string(userSSHPub.Marshal()) {
+				return nil, nil
+			}
+			return nil, ssh.ErrNoAuth
+		},
+	}
+	cfg.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	dir := t.TempDir()
+	srv := &testServer{
+		Addr: l.Addr().String(),
+		Dir:  filepath.Join(dir, "files"),
+	}
+	if err := os.Mkdir(srv.Dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.KnownHosts = filepath.Join(dir, "known_hosts")
+	line := knownhosts.Line([]string{knownhosts.Normalize(srv.Addr)}, hostSigner.PublicKey())
+	if err := os.WriteFile(srv.KnownHosts, []byte(line+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(userKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			srv.mux.Lock()
+			srv.conns = append(srv.conns, conn)
+			srv.dials++
+			srv.mux.Unlock()
+
+			go srv.serve(conn, cfg)
+		}
+	}()
+
+	return srv
+}
+
+func (srv *testServer) serve(conn net.Conn, cfg *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+
+		ch, reqs, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+
+		go func() {
+			for req := range reqs {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if !ok {
+					continue
+				}
+
+				server, err := sftp.NewServer(ch)
+				if err != nil {
+					ch.Close()
+					return
+				}
+				go func() {
+					server.Serve()
+					server.Close()
+				}()
+			}
+		}()
+	}
+}
+
+// dropConnections closes all open connections of the server.
+func (srv *testServer) dropConnections() {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	for _, conn := range srv.conns {
+		conn.Close()
+	}
+	srv.conns = nil
+}
+
+func (srv *testServer) dialCount() int {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	return srv.dials
+}
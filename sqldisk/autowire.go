@@ -0,0 +1,172 @@
+package sqldisk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for SQL databases.
+	Provider = "sql"
+)
+
+// driverDialects are the dialects of well-known database/sql drivers.
+var driverDialects = map[string]Dialect{
+	"sqlite":   SQLite,
+	"sqlite3":  SQLite,
+	"postgres": Postgres,
+	"pgx":      Postgres,
+}
+
+// Schema is the autowire configuration schema for SQL disks.
+var Schema = godrive.ConfigSchema{
+	Description: "SQL database",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "driver",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Name of the database/sql driver, e.g. 'sqlite3' or 'pgx'. The driver must be imported by the application.",
+		},
+		{
+			Key:         "dsn",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "Data source name of the database.",
+		},
+		{
+			Key:         "dialect",
+			Type:        godrive.TypeString,
+			Description: "SQL dialect: 'sqlite' or 'postgres'. Detected from the driver name if not set.",
+		},
+		{
+			Key:         "table",
+			Type:        godrive.TypeString,
+			Default:     DefaultTable,
+			Description: "Name of the table that contains the files.",
+		},
+		{
+			Key:         "chunkSize",
+			Type:        godrive.TypeInt,
+			Default:     DefaultChunkSize,
+			Description: "Maximum size of the content of a single row in bytes. Larger files are split into chunks.",
+		},
+		{
+			Key:         "migrate",
+			Type:        godrive.TypeBool,
+			Default:     false,
+			Description: "Create the tables if they don't exist.",
+		},
+	},
+}
+
+// Register registers SQL databases as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new SQL disk from an autowire configuration.
+// The database is opened by the disk and closed by (*Disk).Close.
+func NewAutoWire(ctx context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	driver, ok := cfg["driver"].(string)
+	if !ok || driver == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "driver",
+			Details: "driver must be set",
+		}
+	}
+
+	dsn, ok := cfg["dsn"].(string)
+	if !ok || dsn == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "dsn",
+			Details: "dsn must be set",
+		}
+	}
+
+	var options []Option
+
+	dialect, ok := driverDialects[driver]
+	if rdialect, isset := cfg["dialect"]; isset {
+		switch rdialect {
+		case string(SQLite), string(Postgres):
+			dialect, ok = Dialect(rdialect.(string)), true
+		default:
+			return nil, InvalidConfigValueError{
+				Key:     "dialect",
+				Details: fmt.Sprintf("dialect must be 'sqlite' or 'postgres' but it is '%v'", rdialect),
+			}
+		}
+	}
+	if !ok {
+		return nil, InvalidConfigValueError{
+			Key:     "dialect",
+			Details: fmt.Sprintf("dialect must be set for driver '%s'", driver),
+		}
+	}
+	options = append(options, UseDialect(dialect))
+
+	if rtable, ok := cfg["table"]; ok {
+		table, ok := rtable.(string)
+		if !ok || !tableName.MatchString(table) {
+			return nil, InvalidConfigValueError{
+				Key:     "table",
+				Details: fmt.Sprintf("table must be a valid table name but it is '%v'", rtable),
+			}
+		}
+		options = append(options, Table(table))
+	}
+
+	if rsize, ok := cfg["chunkSize"]; ok {
+		size, ok := rsize.(int)
+		if !ok || size < 1 {
+			return nil, InvalidConfigValueError{
+				Key:     "chunkSize",
+				Details: fmt.Sprintf("chunk size must be a positive integer but it is '%v'", rsize),
+			}
+		}
+		options = append(options, ChunkSize(size))
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "driver",
+			Details: err.Error(),
+		}
+	}
+
+	disk, err := NewDisk(db, options...)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	disk.ownsDB = true
+
+	if migrate, _ := cfg["migrate"].(bool); migrate {
+		if err := disk.Migrate(ctx); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return disk, nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
@@ -0,0 +1,465 @@
+// Package sqldisk provides a disk that stores files in an SQL database.
+package sqldisk
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// DefaultTable is the default name of the table that contains the files.
+	DefaultTable = "godrive_files"
+	// DefaultChunkSize is the default maximum size of the content of a single row.
+	DefaultChunkSize = 1 << 20
+)
+
+var (
+	// ErrNotFound is returned when a file does not exist.
+	ErrNotFound = errors.New("file not found")
+	// ErrModified is returned by the readers of GetReader when the file is overwritten or
+	// deleted while it is read.
+	ErrModified = errors.New("file modified while reading")
+
+	tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+)
+
+// Dialect is an SQL dialect.
+type Dialect string
+
+const (
+	// SQLite is the dialect of SQLite (default).
+	SQLite = Dialect("sqlite")
+	// Postgres is the dialect of PostgreSQL.
+	Postgres = Dialect("postgres")
+)
+
+// Disk is the SQL disk.
+//
+// Files are stored in a table with the columns path, content, size, content_type, chunks,
+// version, created_at and updated_at. The content of files that are larger than the chunk size is
+// split into chunks that are stored in a second table with the name of the table and the
+// suffix "_chunks". Use Migrate or MigrationSQL to create the tables.
+type Disk struct {
+	DB     *sql.DB
+	Config Config
+
+	ownsDB bool
+}
+
+// Config is the disk configuration.
+type Config struct {
+	Dialect Dialect
+	// Table is the name of the table that contains the files.
+	Table string
+	// ChunkSize is the maximum size of the content of a single row. Larger files are split into chunks.
+	ChunkSize int
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// UseDialect sets the SQL dialect of the database.
+func UseDialect(dialect Dialect) Option {
+	return func(cfg *Config) {
+		cfg.Dialect = dialect
+	}
+}
+
+// Table sets the name of the table that contains the files.
+// The name may be qualified with a schema, e.g. "storage.files".
+func Table(name string) Option {
+	return func(cfg *Config) {
+		cfg.Table = name
+	}
+}
+
+// ChunkSize sets the maximum size of the content of a single row.
+func ChunkSize(size int) Option {
+	return func(cfg *Config) {
+		cfg.ChunkSize = size
+	}
+}
+
+// NewDisk creates a new SQL disk that stores files in db.
+// The disk doesn't close db.
+func NewDisk(db *sql.DB, options ...Option) (*Disk, error) {
+	if db == nil {
+		panic("nil database")
+	}
+
+	cfg := Config{
+		Dialect:   SQLite,
+		Table:     DefaultTable,
+		ChunkSize: DefaultChunkSize,
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	if !tableName.MatchString(cfg.Table) {
+		return nil, fmt.Errorf("invalid table name '%s'", cfg.Table)
+	}
+
+	switch cfg.Dialect {
+	case SQLite, Postgres:
+	default:
+		return nil, fmt.Errorf("unsupported dialect '%s'", cfg.Dialect)
+	}
+
+	if cfg.ChunkSize < 1 {
+		cfg.ChunkSize = DefaultChunkSize
+	}
+
+	return &Disk{
+		DB:     db,
+		Config: cfg,
+	}, nil
+}
+
+// Put writes b to the file at the given path in a single transaction.
+func (d *Disk) Put(ctx context.Context, path string, b []byte) error {
+	return d.put(ctx, path, bytes.NewReader(b))
+}
+
+// PutReader writes r to the file at the given path in a single transaction.
+// r is read chunk by chunk, so large files are not kept in memory.
+func (d *Disk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	return d.put(ctx, path, r)
+}
+
+func (d *Disk) put(ctx context.Context, path string, r io.Reader) error {
+	return d.tx(ctx, func(tx *sql.Tx) error {
+		first, err := readChunk(r, d.Config.ChunkSize)
+		if err != nil {
+			return err
+		}
+
+		var next []byte
+		if len(first) == d.Config.ChunkSize {
+			if next, err = readChunk(r, d.Config.ChunkSize); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, d.query("DELETE FROM %[2]s WHERE path = ?"), path); err != nil {
+			return err
+		}
+
+		content := first
+		size := int64(len(first))
+		var chunks int
+
+		// Files that fit into a single row are stored inline.
+		if len(next) > 0 {
+			content, size = nil, 0
+
+			for chunk := first; len(chunk) > 0; chunks++ {
+				if _, err := tx.ExecContext(ctx, d.query("INSERT INTO %[2]s (path, idx, content) VALUES (?, ?, ?)"), path, chunks, chunk); err != nil {
+					return err
+				}
+				size += int64(len(chunk))
+
+				if chunks == 0 {
+					chunk = next
+				} else if chunk, err = readChunk(r, d.Config.ChunkSize); err != nil {
+					return err
+				}
+			}
+		}
+
+		return d.upsert(ctx, tx, path, fileRow{
+			content:     content,
+			size:        size,
+			contentType: http.DetectContentType(first),
+			chunks:      chunks,
+		})
+	})
+}
+
+type fileRow struct {
+	content     []byte
+	size        int64
+	contentType string
+	chunks      int
+}
+
+// upsert inserts or updates the row of a file and increments its version.
+// An UPDATE followed by an INSERT works in all dialects and keeps created_at of existing files.
+func (d *Disk) upsert(ctx context.Context, tx *sql.Tx, path string, row fileRow) error {
+	now := time.Now().UTC()
+
+	res, err := tx.ExecContext(ctx, d.query(
+		"UPDATE %[1]s SET content = ?, size = ?, content_type = ?, chunks = ?, version = version + 1, updated_at = ? WHERE path = ?",
+	), row.content, row.size, row.contentType, row.chunks, now, path)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, d.query(
+		"INSERT INTO %[1]s (path, content, size, content_type, chunks, version, created_at, updated_at) VALUES (?, ?, ?, ?, ?, 1, ?, ?)",
+	), path, row.content, row.size, row.contentType, row.chunks, now, now)
+
+	return err
+}
+
+// readChunk reads up to size bytes from r.
+func readChunk(r io.Reader, size int) ([]byte, error) {
+	buf := make([]byte, size)
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return buf[:n], err
+}
+
+// Get retrieves the file at the given path.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Get(ctx context.Context, path string) ([]byte, error) {
+	var b []byte
+	err := d.tx(ctx, func(tx *sql.Tx) error {
+		var chunks int
+		if err := tx.QueryRowContext(ctx, d.query("SELECT content, chunks FROM %[1]s WHERE path = ?"), path).Scan(&b, &chunks); err != nil {
+			return notFound(err)
+		}
+
+		if chunks == 0 {
+			return nil
+		}
+
+		rows, err := tx.QueryContext(ctx, d.query("SELECT content FROM %[2]s WHERE path = ? ORDER BY idx"), path)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var chunk []byte
+			if err := rows.Scan(&chunk); err != nil {
+				return err
+			}
+			b = append(b, chunk...)
+		}
+
+		return rows.Err()
+	})
+
+	return b, err
+}
+
+// GetReader returns a reader for the file at the given path.
+// The chunks of large files are loaded one at a time while the reader is read, each in its own
+// query, so the reader doesn't block writers. If the file is overwritten or deleted before all
+// chunks are read, the reader returns ErrModified instead of mixing the content of different
+// versions of the file.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	var content []byte
+	var chunks int
+	var version int64
+	if err := d.DB.QueryRowContext(ctx, d.query("SELECT content, chunks, version FROM %[1]s WHERE path = ?"), path).Scan(
+		&content, &chunks, &version,
+	); err != nil {
+		return nil, notFound(err)
+	}
+
+	if chunks == 0 {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+
+	return &chunkReader{ctx: ctx, disk: d, path: path, chunks: chunks, version: version}, nil
+}
+
+type chunkReader struct {
+	ctx     context.Context
+	disk    *Disk
+	path    string
+	chunks  int
+	version int64
+
+	idx int
+	buf []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.idx >= r.chunks {
+			return 0, io.EOF
+		}
+
+		// The chunk is only returned if the file still has the version that the reader started with.
+		if err := r.disk.DB.QueryRowContext(r.ctx, r.disk.query(
+			"SELECT c.content FROM %[2]s c JOIN %[1]s f ON f.path = c.path WHERE c.path = ? AND c.idx = ? AND f.version = ?",
+		), r.path, r.idx, r.version).Scan(&r.buf); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, ErrModified
+			}
+			return 0, err
+		}
+		r.idx++
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+func (r *chunkReader) Close() error {
+	return nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(ctx context.Context, path string) error {
+	return d.tx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, d.query("DELETE FROM %[2]s WHERE path = ?"), path); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, d.query("DELETE FROM %[1]s WHERE path = ?"), path)
+		return err
+	})
+}
+
+// List returns the paths of all files whose path begins with prefix, sorted by path.
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	escaped := strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(prefix)
+
+	rows, err := d.DB.QueryContext(ctx, d.query("SELECT path FROM %[1]s WHERE path LIKE ? ESCAPE '!' ORDER BY path"), escaped+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, rows.Err()
+}
+
+// Stat returns information about the file at the given path.
+// The content type is detected from the content when the file is written.
+// If the file does not exist, it returns ErrNotFound.
+func (d *Disk) Stat(ctx context.Context, path string) (godrive.FileInfo, error) {
+	info := godrive.FileInfo{Path: path}
+	if err := d.DB.QueryRowContext(ctx, d.query("SELECT size, content_type, updated_at FROM %[1]s WHERE path = ?"), path).Scan(
+		&info.Size, &info.ContentType, &info.ModTime,
+	); err != nil {
+		return godrive.FileInfo{}, notFound(err)
+	}
+
+	return info, nil
+}
+
+// Copy copies the file at src to dst in a single transaction.
+// If src does not exist, it returns ErrNotFound.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	return d.tx(ctx, func(tx *sql.Tx) error {
+		var row fileRow
+		if err := tx.QueryRowContext(ctx, d.query("SELECT content, size, content_type, chunks FROM %[1]s WHERE path = ?"), src).Scan(
+			&row.content, &row.size, &row.contentType, &row.chunks,
+		); err != nil {
+			return notFound(err)
+		}
+
+		// Deleting the chunks of dst would delete the chunks of src.
+		if src == dst {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, d.query("DELETE FROM %[2]s WHERE path = ?"), dst); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, d.query(
+			"INSERT INTO %[2]s (path, idx, content) SELECT ?, idx, content FROM %[2]s WHERE path = ?",
+		), dst, src); err != nil {
+			return err
+		}
+
+		return d.upsert(ctx, tx, dst, row)
+	})
+}
+
+// CheckHealth checks if the database is reachable and the table exists.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	var n int
+	return d.DB.QueryRowContext(ctx, d.query("SELECT COUNT(*) FROM %[1]s WHERE path = ?"), "").Scan(&n)
+}
+
+// Close closes the database if it was opened by NewAutoWire.
+func (d *Disk) Close() error {
+	if d.ownsDB {
+		return d.DB.Close()
+	}
+	return nil
+}
+
+// tx runs fn in a transaction and commits it if fn succeeds.
+func (d *Disk) tx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// query inserts the table names into query (%[1]s is the table of the files and %[2]s is
+// the table of the chunks) and replaces the placeholders for the dialect.
+func (d *Disk) query(query string) string {
+	query = fmt.Sprintf(query, d.Config.Table, d.chunkTable())
+
+	if d.Config.Dialect != Postgres {
+		return query
+	}
+
+	var b strings.Builder
+	var n int
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+func (d *Disk) chunkTable() string {
+	return d.Config.Table + "_chunks"
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,290 @@
+package sqldisk_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/sqldisk"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDisk(t *testing.T, config map[string]interface{}) *sqldisk.Disk {
+	cfg := map[string]interface{}{
+		"driver":    "sqlite3",
+		"dsn":       filepath.Join(t.TempDir(), "files.db"),
+		"chunkSize": 4,
+		"migrate":   true,
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := sqldisk.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { disk.(*sqldisk.Disk).Close() })
+
+	return disk.(*sqldisk.Disk)
+}
+
+func TestDisk(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	// The chunk size is 4, so "content1" is split into two chunks and "abc" is stored inline.
+	assert.Nil(t, disk.Put(ctx, "dir/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/sub/file2.txt", strings.NewReader("abc")))
+	assert.Nil(t, disk.Put(ctx, "dir_x/other.txt", []byte("abcd")))
+	assert.Nil(t, disk.Put(ctx, "empty.txt", nil))
+
+	for path, content := range map[string]string{
+		"dir/file1.txt":     "content1",
+		"dir/sub/file2.txt": "abc",
+		"dir_x/other.txt":   "abcd",
+		"empty.txt":         "",
+	} {
+		b, err := disk.Get(ctx, path)
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(b))
+
+		r, err := disk.GetReader(ctx, path)
+		assert.Nil(t, err)
+		b, _ = io.ReadAll(r)
+		assert.Nil(t, r.Close())
+		assert.Equal(t, content, string(b))
+	}
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/file1.txt", "dir/sub/file2.txt"}, paths)
+
+	// "_" must not match any character.
+	paths, err = disk.List(ctx, "dir_")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir_x/other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Len(t, paths, 4)
+
+	info, err := disk.Stat(ctx, "dir/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.Equal(t, "text/plain; charset=utf-8", info.ContentType)
+	assert.WithinDuration(t, time.Now(), info.ModTime, time.Minute)
+
+	assert.Nil(t, disk.Copy(ctx, "dir/file1.txt", "copies/file1.txt"))
+	b, err := disk.Get(ctx, "copies/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	assert.Nil(t, disk.Delete(ctx, "dir/file1.txt"))
+	_, err = disk.Get(ctx, "dir/file1.txt")
+	assert.Equal(t, sqldisk.ErrNotFound, err)
+	_, err = disk.Stat(ctx, "dir/file1.txt")
+	assert.Equal(t, sqldisk.ErrNotFound, err)
+
+	// The chunks of the copy are not affected.
+	b, err = disk.Get(ctx, "copies/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_overwrite(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("a long content")))
+	created, err := disk.Stat(ctx, "file.txt")
+	assert.Nil(t, err)
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("short")))
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("short"), b)
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("tiny")))
+	b, err = disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("tiny"), b)
+
+	var chunks int
+	assert.Nil(t, disk.DB.QueryRow("SELECT COUNT(*) FROM godrive_files_chunks").Scan(&chunks))
+	assert.Equal(t, 0, chunks)
+
+	info, err := disk.Stat(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.False(t, info.ModTime.Before(created.ModTime))
+}
+
+type failingReader struct {
+	r io.Reader
+}
+
+func (r failingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err == io.EOF {
+		return n, io.ErrClosedPipe
+	}
+	return n, err
+}
+
+func TestDisk_transactionalPut(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("old content")))
+
+	err := disk.PutReader(ctx, "file.txt", failingReader{r: bytes.NewReader([]byte("new content"))})
+	assert.Equal(t, io.ErrClosedPipe, err)
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old content"), b)
+}
+
+func TestDisk_copySelf(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("chunked content")))
+	assert.Nil(t, disk.Copy(ctx, "file.txt", "file.txt"))
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("chunked content"), b)
+}
+
+func TestDisk_GetReader_concurrentPut(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("aaaabbbbcccc")))
+
+	r, err := disk.GetReader(ctx, "file.txt")
+	assert.Nil(t, err)
+	defer r.Close()
+
+	first := make([]byte, 4)
+	_, err = io.ReadFull(r, first)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("aaaa"), first)
+
+	// An open reader doesn't block writers.
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("xxxxyyyyzzzz")))
+
+	// The reader doesn't mix the content of both versions.
+	_, err = io.ReadAll(r)
+	assert.Equal(t, sqldisk.ErrModified, err)
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("xxxxyyyyzzzz"), b)
+}
+
+func TestDisk_GetReader_concurrentDelete(t *testing.T) {
+	disk := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("aaaabbbb")))
+
+	r, err := disk.GetReader(ctx, "file.txt")
+	assert.Nil(t, err)
+	defer r.Close()
+
+	assert.Nil(t, disk.Delete(ctx, "file.txt"))
+
+	_, err = io.ReadAll(r)
+	assert.Equal(t, sqldisk.ErrModified, err)
+}
+
+func TestDisk_table(t *testing.T) {
+	disk := newTestDisk(t, map[string]interface{}{"table": "uploads"})
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+
+	var n int
+	assert.Nil(t, disk.DB.QueryRow("SELECT COUNT(*) FROM uploads").Scan(&n))
+	assert.Equal(t, 1, n)
+	assert.Nil(t, disk.DB.QueryRow("SELECT COUNT(*) FROM uploads_chunks").Scan(&n))
+	assert.Equal(t, 2, n)
+}
+
+func TestDisk_notMigrated(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "files.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	disk, err := sqldisk.NewDisk(db)
+	assert.Nil(t, err)
+	assert.NotNil(t, disk.CheckHealth(context.Background()))
+
+	assert.Nil(t, disk.Migrate(context.Background()))
+	assert.Nil(t, disk.Migrate(context.Background()))
+	assert.Nil(t, disk.CheckHealth(context.Background()))
+
+	// The disk doesn't close a database it didn't open.
+	assert.Nil(t, disk.Close())
+	assert.Nil(t, db.Ping())
+}
+
+func TestDisk_MigrationSQL(t *testing.T) {
+	db, _ := sql.Open("sqlite3", ":memory:")
+	defer db.Close()
+
+	disk, err := sqldisk.NewDisk(db, sqldisk.UseDialect(sqldisk.Postgres), sqldisk.Table("storage.files"))
+	assert.Nil(t, err)
+
+	stmts := disk.MigrationSQL()
+	assert.Len(t, stmts, 2)
+	assert.Contains(t, stmts[0], "CREATE TABLE IF NOT EXISTS storage.files (")
+	assert.Contains(t, stmts[0], "content BYTEA")
+	assert.Contains(t, stmts[1], "CREATE TABLE IF NOT EXISTS storage.files_chunks (")
+}
+
+func TestNewAutoWire(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := sqldisk.NewAutoWire(ctx, map[string]interface{}{"dsn": "files.db"})
+	assert.Equal(t, sqldisk.InvalidConfigValueError{
+		Key:     "driver",
+		Details: "driver must be set",
+	}, err)
+
+	_, err = sqldisk.NewAutoWire(ctx, map[string]interface{}{"driver": "mysql", "dsn": "files"})
+	assert.Equal(t, sqldisk.InvalidConfigValueError{
+		Key:     "dialect",
+		Details: "dialect must be set for driver 'mysql'",
+	}, err)
+
+	_, err = sqldisk.NewAutoWire(ctx, map[string]interface{}{"driver": "sqlite3", "dsn": ":memory:", "table": "files; DROP TABLE users"})
+	assert.Equal(t, sqldisk.InvalidConfigValueError{
+		Key:     "table",
+		Details: "table must be a valid table name but it is 'files; DROP TABLE users'",
+	}, err)
+
+	_, err = sqldisk.NewAutoWire(ctx, map[string]interface{}{"driver": "unknown", "dialect": "postgres", "dsn": "files"})
+	assert.Equal(t, "driver", err.(sqldisk.InvalidConfigValueError).Key)
+
+	disk, err := sqldisk.NewAutoWire(ctx, map[string]interface{}{"driver": "sqlite3", "dsn": ":memory:"})
+	assert.Nil(t, err)
+	assert.Equal(t, sqldisk.SQLite, disk.(*sqldisk.Disk).Config.Dialect)
+	assert.Equal(t, sqldisk.DefaultTable, disk.(*sqldisk.Disk).Config.Table)
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapStreaming|godrive.CapListing|godrive.CapStat|godrive.CapCopy|godrive.CapHealth))
+	disk.(*sqldisk.Disk).Close()
+}
@@ -0,0 +1,49 @@
+package sqldisk
+
+import (
+	"context"
+	"fmt"
+)
+
+// columnTypes are the column types of the dialects.
+var columnTypes = map[Dialect]struct {
+	text, blob, bigint, int, timestamp string
+}{
+	SQLite:   {text: "TEXT", blob: "BLOB", bigint: "INTEGER", int: "INTEGER", timestamp: "TIMESTAMP"},
+	Postgres: {text: "TEXT", blob: "BYTEA", bigint: "BIGINT", int: "INTEGER", timestamp: "TIMESTAMPTZ"},
+}
+
+// MigrationSQL returns the statements that create the tables of the disk if they don't exist.
+// Use it to add the tables to the migrations of an application; otherwise use Migrate.
+func (d *Disk) MigrationSQL() []string {
+	t := columnTypes[d.Config.Dialect]
+
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	path %s NOT NULL PRIMARY KEY,
+	content %s,
+	size %s NOT NULL,
+	content_type %s NOT NULL,
+	chunks %s NOT NULL,
+	version %s NOT NULL,
+	created_at %s NOT NULL,
+	updated_at %s NOT NULL
+)`, d.Config.Table, t.text, t.blob, t.bigint, t.text, t.int, t.bigint, t.timestamp, t.timestamp),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	path %s NOT NULL,
+	idx %s NOT NULL,
+	content %s NOT NULL,
+	PRIMARY KEY (path, idx)
+)`, d.chunkTable(), t.text, t.int, t.blob),
+	}
+}
+
+// Migrate creates the tables of the disk if they don't exist.
+func (d *Disk) Migrate(ctx context.Context) error {
+	for _, stmt := range d.MigrationSQL() {
+		if _, err := d.DB.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migrate: %w", err)
+		}
+	}
+	return nil
+}
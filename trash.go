@@ -0,0 +1,274 @@
+package godrive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTrashPrefix is the default prefix of deleted files on the trash Disk.
+	DefaultTrashPrefix = ".trash/"
+
+	// DeletedAtKey is the metadata key of the deletion time of files in the trash (RFC 3339).
+	DeletedAtKey = "godrive-deleted-at"
+)
+
+// TrashOption is an option for the trash decorator.
+type TrashOption func(*TrashBin)
+
+// TrashPrefix sets the prefix of deleted files on the trash Disk. Defaults to DefaultTrashPrefix.
+func TrashPrefix(prefix string) TrashOption {
+	return func(t *TrashBin) {
+		t.prefix = prefix
+	}
+}
+
+// OnPurge sets a function that is called after every purge of RunPurge.
+func OnPurge(fn func(purged []string, err error)) TrashOption {
+	return func(t *TrashBin) {
+		t.onPurge = fn
+	}
+}
+
+// TrashBin manages the files that the Disk returned by Trash moved into the trash.
+// Deleted files can be restored until they are purged after the retention period.
+type TrashBin struct {
+	next      Disk
+	trash     Disk
+	retention time.Duration
+	prefix    string
+	onPurge   func([]string, error)
+}
+
+// TrashItem is a file in the trash.
+type TrashItem struct {
+	// Path is the path of the file before it was deleted.
+	Path      string
+	DeletedAt time.Time
+}
+
+// Trash wraps disk so that Delete moves files to trashDisk (under a prefix, see TrashPrefix) instead of
+// deleting them. trashDisk may be disk itself, in which case the trash is excluded from List.
+// The returned Disk implements the same optional interfaces as disk. The returned TrashBin restores,
+// lists and purges the files in the trash. Files in the trash are purged after the retention period.
+//
+// The deletion time is stored in the metadata of the file in the trash (DeletedAtKey) if trashDisk
+// supports metadata (CapMetadata), together with the custom metadata of the deleted file.
+// Otherwise the modification time is used, which requires trashDisk to support CapStat.
+// Deleting a file that is already in the trash replaces the file in the trash.
+func Trash(disk, trashDisk Disk, retention time.Duration, options ...TrashOption) (Disk, *TrashBin) {
+	t := &TrashBin{
+		next:      disk,
+		trash:     trashDisk,
+		retention: retention,
+		prefix:    DefaultTrashPrefix,
+	}
+	for _, opt := range options {
+		opt(t)
+	}
+
+	return Intercept(Interceptor{
+		Delete: t.delete,
+		List:   t.list,
+	})(disk), t
+}
+
+func (t *TrashBin) trashPath(path string) string {
+	return t.prefix + path
+}
+
+func (t *TrashBin) delete(ctx context.Context, path string, next DeleteFunc) error {
+	b, err := t.next.Get(ctx, path)
+	if err != nil {
+		return fmt.Errorf("move '%s' to trash: %w", path, err)
+	}
+
+	metadata := make(map[string]string)
+	if mdisk, ok := metadataProvider(t.next); ok {
+		md, err := mdisk.GetMetadata(ctx, path)
+		if err != nil {
+			return fmt.Errorf("move '%s' to trash: %w", path, err)
+		}
+		for k, v := range md {
+			metadata[k] = v
+		}
+	}
+	metadata[DeletedAtKey] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	tpath := t.trashPath(path)
+	if err := t.trash.Put(ctx, tpath, b); err != nil {
+		return fmt.Errorf("move '%s' to trash: %w", path, err)
+	}
+
+	if mdisk, ok := metadataProvider(t.trash); ok {
+		if err := mdisk.SetMetadata(ctx, tpath, metadata); err != nil {
+			return fmt.Errorf("move '%s' to trash: %w", path, err)
+		}
+	}
+
+	return next(ctx, path)
+}
+
+// list hides the trash if it is stored on the same Disk.
+func (t *TrashBin) list(ctx context.Context, prefix string, next ListFunc) ([]string, error) {
+	paths, err := next(ctx, prefix)
+	if err != nil || !sameDisk(t.next, t.trash) {
+		return paths, err
+	}
+
+	filtered := paths[:0]
+	for _, p := range paths {
+		if !strings.HasPrefix(p, t.prefix) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered, nil
+}
+
+// metadataProvider returns disk as a MetadataProvider if it supports metadata.
+func metadataProvider(disk Disk) (MetadataProvider, bool) {
+	mdisk, ok := disk.(MetadataProvider)
+	return mdisk, ok && Capabilities(disk).Has(CapMetadata)
+}
+
+func sameDisk(a, b Disk) (same bool) {
+	// Comparing interfaces panics if the dynamic type is not comparable.
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
+
+// Restore moves the deleted file at the given path from the trash back to the wrapped Disk,
+// including its custom metadata.
+func (t *TrashBin) Restore(ctx context.Context, path string) error {
+	tpath := t.trashPath(path)
+
+	b, err := t.trash.Get(ctx, tpath)
+	if err != nil {
+		return fmt.Errorf("restore '%s': %w", path, err)
+	}
+
+	var metadata map[string]string
+	if mdisk, ok := metadataProvider(t.trash); ok {
+		if metadata, err = mdisk.GetMetadata(ctx, tpath); err != nil {
+			return fmt.Errorf("restore '%s': %w", path, err)
+		}
+		delete(metadata, DeletedAtKey)
+	}
+
+	if err := t.next.Put(ctx, path, b); err != nil {
+		return fmt.Errorf("restore '%s': %w", path, err)
+	}
+
+	if mdisk, ok := metadataProvider(t.next); ok && len(metadata) > 0 {
+		if err := mdisk.SetMetadata(ctx, path, metadata); err != nil {
+			return fmt.Errorf("restore '%s': %w", path, err)
+		}
+	}
+
+	return t.trash.Delete(ctx, tpath)
+}
+
+// ListTrash returns the files in the trash whose original path begins with prefix.
+// The trash Disk must support listing (CapListing).
+func (t *TrashBin) ListTrash(ctx context.Context, prefix string) ([]TrashItem, error) {
+	ldisk, ok := t.trash.(Lister)
+	if !ok || !Capabilities(t.trash).Has(CapListing) {
+		return nil, UnimplementedError{Interface: new(Lister)}
+	}
+
+	paths, err := ldisk.List(ctx, t.trashPath(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]TrashItem, 0, len(paths))
+	for _, p := range paths {
+		deletedAt, err := t.deletedAt(ctx, p)
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, TrashItem{
+			Path:      strings.TrimPrefix(p, t.prefix),
+			DeletedAt: deletedAt,
+		})
+	}
+
+	return items, nil
+}
+
+func (t *TrashBin) deletedAt(ctx context.Context, tpath string) (time.Time, error) {
+	if mdisk, ok := metadataProvider(t.trash); ok {
+		md, err := mdisk.GetMetadata(ctx, tpath)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if v, ok := md[DeletedAtKey]; ok {
+			return time.Parse(time.RFC3339Nano, v)
+		}
+	}
+
+	sdisk, ok := t.trash.(Stater)
+	if !ok || !Capabilities(t.trash).Has(CapStat) {
+		return time.Time{}, UnimplementedError{Interface: new(Stater)}
+	}
+
+	info, err := sdisk.Stat(ctx, tpath)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return info.ModTime, nil
+}
+
+// Purge permanently deletes the files in the trash that were deleted before the retention period
+// and returns their original paths.
+func (t *TrashBin) Purge(ctx context.Context) ([]string, error) {
+	items, err := t.ListTrash(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(-t.retention)
+
+	var purged []string
+	for _, item := range items {
+		if !item.DeletedAt.Before(deadline) {
+			continue
+		}
+
+		if err := t.trash.Delete(ctx, t.trashPath(item.Path)); err != nil {
+			return purged, fmt.Errorf("purge '%s': %w", item.Path, err)
+		}
+		purged = append(purged, item.Path)
+	}
+
+	return purged, nil
+}
+
+// RunPurge purges the trash every interval until ctx is canceled, and then returns ctx.Err().
+// Use OnPurge to be notified about the results.
+func (t *TrashBin) RunPurge(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := t.Purge(ctx)
+		if t.onPurge != nil {
+			t.onPurge(purged, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
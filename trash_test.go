@@ -0,0 +1,197 @@
+package godrive_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+type metadataDisk struct {
+	memdisk.Lister
+	mux      sync.Mutex
+	metadata map[string]map[string]string
+}
+
+func newMetadataDisk() *metadataDisk {
+	return &metadataDisk{
+		Lister:   memdisk.NewLister(),
+		metadata: make(map[string]map[string]string),
+	}
+}
+
+func (d *metadataDisk) GetMetadata(_ context.Context, path string) (map[string]string, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	md := make(map[string]string)
+	for k, v := range d.metadata[path] {
+		md[k] = v
+	}
+	return md, nil
+}
+
+func (d *metadataDisk) SetMetadata(_ context.Context, path string, metadata map[string]string) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.metadata[path] = metadata
+	return nil
+}
+
+func TestTrash(t *testing.T) {
+	base := newMetadataDisk()
+	trashDisk := newMetadataDisk()
+	disk, trash := godrive.Trash(base, trashDisk, time.Hour)
+	ctx := context.Background()
+
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapListing|godrive.CapStat|godrive.CapMetadata))
+	_, ok := disk.(godrive.URLProvider)
+	assert.False(t, ok)
+
+	assert.Nil(t, disk.Put(ctx, "dir/file.txt", []byte("content")))
+	assert.Nil(t, disk.(godrive.MetadataProvider).SetMetadata(ctx, "dir/file.txt", map[string]string{"owner": "bob"}))
+
+	assert.Nil(t, disk.Delete(ctx, "dir/file.txt"))
+	_, err := disk.Get(ctx, "dir/file.txt")
+	assert.NotNil(t, err)
+
+	b, err := trashDisk.Get(ctx, godrive.DefaultTrashPrefix+"dir/file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+
+	md, _ := trashDisk.GetMetadata(ctx, godrive.DefaultTrashPrefix+"dir/file.txt")
+	assert.Equal(t, "bob", md["owner"])
+	deletedAt, err := time.Parse(time.RFC3339Nano, md[godrive.DeletedAtKey])
+	assert.Nil(t, err)
+	assert.WithinDuration(t, time.Now(), deletedAt, time.Minute)
+
+	items, err := trash.ListTrash(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []godrive.TrashItem{{Path: "dir/file.txt", DeletedAt: deletedAt}}, items)
+
+	assert.Nil(t, trash.Restore(ctx, "dir/file.txt"))
+	b, err = disk.Get(ctx, "dir/file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+	md, _ = disk.(godrive.MetadataProvider).GetMetadata(ctx, "dir/file.txt")
+	assert.Equal(t, map[string]string{"owner": "bob"}, md)
+
+	items, err = trash.ListTrash(ctx, "")
+	assert.Nil(t, err)
+	assert.Empty(t, items)
+
+	assert.NotNil(t, trash.Restore(ctx, "dir/file.txt"))
+	assert.NotNil(t, disk.Delete(ctx, "missing.txt"))
+}
+
+func TestTrash_sameDisk(t *testing.T) {
+	base := newMetadataDisk()
+	disk, trash := godrive.Trash(base, base, time.Hour, godrive.TrashPrefix("_trash/"))
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "a.txt", []byte("a")))
+	assert.Nil(t, disk.Put(ctx, "b.txt", []byte("b")))
+	assert.Nil(t, disk.Delete(ctx, "a.txt"))
+
+	// The trash is hidden.
+	paths, err := disk.(godrive.Lister).List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b.txt"}, paths)
+
+	items, err := trash.ListTrash(ctx, "")
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "a.txt", items[0].Path)
+}
+
+func TestTrash_Purge(t *testing.T) {
+	trashDisk := newMetadataDisk()
+	ctx := context.Background()
+
+	disk, trash := godrive.Trash(newMetadataDisk(), trashDisk, time.Hour)
+	assert.Nil(t, disk.Put(ctx, "old.txt", []byte("old")))
+	assert.Nil(t, disk.Put(ctx, "new.txt", []byte("new")))
+	assert.Nil(t, disk.Delete(ctx, "old.txt"))
+	assert.Nil(t, disk.Delete(ctx, "new.txt"))
+
+	trashDisk.SetMetadata(ctx, godrive.DefaultTrashPrefix+"old.txt", map[string]string{
+		godrive.DeletedAtKey: time.Now().Add(-2 * time.Hour).Format(time.RFC3339Nano),
+	})
+
+	purged, err := trash.Purge(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"old.txt"}, purged)
+
+	items, err := trash.ListTrash(ctx, "")
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "new.txt", items[0].Path)
+}
+
+func TestTrash_RunPurge(t *testing.T) {
+	base := newMetadataDisk()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runs := make(chan []string, 10)
+	disk, trash := godrive.Trash(base, base, 0, godrive.OnPurge(func(purged []string, err error) {
+		assert.Nil(t, err)
+		runs <- purged
+	}))
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, disk.Delete(ctx, "file.txt"))
+
+	done := make(chan error)
+	go func() { done <- trash.RunPurge(ctx, 10*time.Millisecond) }()
+
+	assert.Equal(t, []string{"file.txt"}, <-runs)
+	assert.Empty(t, <-runs)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
+
+func TestTrash_decorated(t *testing.T) {
+	base := newMetadataDisk()
+	trashBase := newMetadataDisk()
+	trashDisk := godrive.Intercept(godrive.Interceptor{})(trashBase)
+	disk, trash := godrive.Trash(godrive.WithLogger(base, slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))), trashDisk, time.Hour)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, base.SetMetadata(ctx, "file.txt", map[string]string{"owner": "bob"}))
+	assert.Nil(t, disk.Delete(ctx, "file.txt"))
+
+	md, _ := trashBase.GetMetadata(ctx, godrive.DefaultTrashPrefix+"file.txt")
+	assert.Equal(t, "bob", md["owner"])
+	assert.NotEmpty(t, md[godrive.DeletedAtKey])
+
+	assert.Nil(t, trash.Restore(ctx, "file.txt"))
+	md, _ = base.GetMetadata(ctx, "file.txt")
+	assert.Equal(t, map[string]string{"owner": "bob"}, md)
+
+	assert.Nil(t, disk.Delete(ctx, "file.txt"))
+	trashBase.SetMetadata(ctx, godrive.DefaultTrashPrefix+"file.txt", map[string]string{
+		godrive.DeletedAtKey: time.Now().Add(-2 * time.Hour).Format(time.RFC3339Nano),
+	})
+
+	purged, err := trash.Purge(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"file.txt"}, purged)
+
+	items, err := trash.ListTrash(ctx, "")
+	assert.Nil(t, err)
+	assert.Empty(t, items)
+}
+
+func TestTrash_unimplemented(t *testing.T) {
+	_, trash := godrive.Trash(memdisk.New(), memdisk.New(), time.Hour)
+
+	_, err := trash.ListTrash(context.Background(), "")
+	assert.Equal(t, godrive.UnimplementedError{Interface: new(godrive.Lister)}, err)
+}
@@ -0,0 +1,95 @@
+package godrive
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// URIScheme is the scheme of disk URIs.
+	URIScheme = "disk"
+
+	uriPrefix = URIScheme + "://"
+)
+
+// URI returns the disk URI for the file at path on the Disk with the given name, e.g.
+//
+//	disk://videos/path/file.mp4
+//
+// Disk URIs can be stored (e.g. in a database) and passed to the operations of a Manager,
+// which resolves the Disk from the URI.
+func URI(diskname, path string) string {
+	return uriPrefix + diskname + "/" + strings.TrimPrefix(path, "/")
+}
+
+// IsURI determines if s is a disk URI.
+func IsURI(s string) bool {
+	return strings.HasPrefix(s, uriPrefix)
+}
+
+// ParseURI parses a disk URI and returns the disk name and the path on the disk.
+// If uri is not a valid disk URI, it returns an InvalidURIError.
+func ParseURI(uri string) (string, string, error) {
+	if !IsURI(uri) {
+		return "", "", InvalidURIError{
+			URI:     uri,
+			Details: fmt.Sprintf("scheme must be '%s'", URIScheme),
+		}
+	}
+
+	name, path, _ := strings.Cut(strings.TrimPrefix(uri, uriPrefix), "/")
+	if name == "" {
+		return "", "", InvalidURIError{
+			URI:     uri,
+			Details: "disk name must be set",
+		}
+	}
+
+	return name, path, nil
+}
+
+// InvalidURIError means a disk URI is malformed.
+type InvalidURIError struct {
+	URI     string
+	Details string
+}
+
+func (err InvalidURIError) Error() string {
+	return fmt.Sprintf("invalid disk uri '%s': %s", err.URI, err.Details)
+}
+
+// On returns the Disk with the given name. In contrast to (*Manager).Disk(),
+// the Disk is resolved on every operation, so On never fails and an
+// UnconfiguredDiskError is returned by the operations instead.
+//
+// The returned Disk implements the optional interfaces of the Disk that is
+// configured when On is called. If the configured Disk is replaced by a Disk
+// that does not implement an interface, the operation returns an UnimplementedError.
+// On doesn't create lazily initialized Disks (see LazyInit). Their interfaces are
+// unknown until they are created, so the returned Disk implements all optional interfaces.
+func (m *Manager) On(name string) Disk {
+	d := &interceptedDisk{
+		name:    name,
+		resolve: func() (Disk, error) { return m.Disk(name) },
+		caps:    m.configuredCapabilities(name),
+	}
+
+	return interceptedTypes[d.caps](d)
+}
+
+// configuredCapabilities returns the capabilities of the configured Disk with the given name
+// without creating it. All capabilities are returned for Disks that are not created yet.
+func (m *Manager) configuredCapabilities(name string) Capability {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	if disk, ok := m.wrapped[name]; ok {
+		return Capabilities(disk) & capAll
+	}
+
+	if _, ok := m.pending[name]; ok {
+		return capAll
+	}
+
+	return 0
+}
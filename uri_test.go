@@ -0,0 +1,121 @@
+package godrive_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		uri   string
+		disk  string
+		path  string
+		valid bool
+	}{
+		{uri: "disk://videos/path/file.mp4", disk: "videos", path: "path/file.mp4", valid: true},
+		{uri: "disk://videos/", disk: "videos", path: "", valid: true},
+		{uri: "disk:///path/file.mp4"},
+		{uri: "path/file.mp4"},
+		{uri: "s3://videos/path/file.mp4"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			disk, path, err := godrive.ParseURI(test.uri)
+			if !test.valid {
+				assert.True(t, errors.As(err, &godrive.InvalidURIError{}))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.disk, disk)
+			assert.Equal(t, test.path, path)
+			assert.Equal(t, test.uri, godrive.URI(disk, path))
+		})
+	}
+}
+
+func TestManager_uri(t *testing.T) {
+	m := godrive.New()
+	main, videos := memdisk.New(), urlDisk{memdisk.New()}
+	m.Configure("main", main)
+	m.Configure("videos", videos)
+
+	ctx := context.Background()
+	uri := godrive.URI("videos", "path/file.mp4")
+
+	assert.Nil(t, m.Put(ctx, uri, []byte("video")))
+
+	b, err := videos.Get(ctx, "path/file.mp4")
+	assert.Nil(t, err)
+	assert.Equal(t, "video", string(b))
+
+	b, err = m.Get(ctx, uri)
+	assert.Nil(t, err)
+	assert.Equal(t, "video", string(b))
+
+	url, err := m.GetURL(ctx, uri)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.test/path/file.mp4", url)
+
+	_, err = m.GetURL(ctx, "path/file.mp4")
+	assert.Equal(t, godrive.UnimplementedError{DiskName: "main", Interface: new(godrive.URLProvider)}, err)
+
+	assert.Nil(t, m.Delete(ctx, uri))
+	_, err = videos.Get(ctx, "path/file.mp4")
+	assert.NotNil(t, err)
+
+	err = m.Put(ctx, godrive.URI("images", "file.png"), nil)
+	assert.Equal(t, godrive.UnconfiguredDiskError{Name: "images"}, err)
+}
+
+func TestManager_On(t *testing.T) {
+	m := godrive.New()
+	ctx := context.Background()
+
+	disk := m.On("videos")
+	assert.Equal(t, godrive.UnconfiguredDiskError{Name: "videos"}, disk.Put(ctx, "file.mp4", nil))
+
+	m.Configure("videos", memdisk.New())
+	assert.Nil(t, disk.Put(ctx, "file.mp4", []byte("video")))
+
+	b, err := disk.Get(ctx, "file.mp4")
+	assert.Nil(t, err)
+	assert.Equal(t, "video", string(b))
+
+	// The Disk was not configured when On was called.
+	_, ok := disk.(godrive.Lister)
+	assert.False(t, ok)
+	assert.Equal(t, godrive.Capability(0), godrive.Capabilities(disk))
+}
+
+func TestManager_On_interfaces(t *testing.T) {
+	m := godrive.New()
+	m.Configure("videos", memdisk.NewLister())
+	ctx := context.Background()
+
+	disk := m.On("videos")
+	_, ok := disk.(godrive.Streamer)
+	assert.False(t, ok)
+	assert.Equal(t, godrive.CapListing|godrive.CapStat, godrive.Capabilities(disk))
+
+	assert.Nil(t, disk.Put(ctx, "file.mp4", []byte("video")))
+	paths, err := disk.(godrive.Lister).List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"file.mp4"}, paths)
+
+	// The configured Disk is replaced by a Disk without Lister.
+	m.Configure("videos", memdisk.New(), godrive.Replace())
+	_, err = disk.(godrive.Lister).List(ctx, "")
+	assert.Equal(t, godrive.UnimplementedError{DiskName: "videos", Interface: new(godrive.Lister)}, err)
+	assert.Equal(t, godrive.Capability(0), godrive.Capabilities(disk))
+
+	m.RemoveDisk("videos")
+	_, err = disk.(godrive.Lister).List(ctx, "")
+	assert.Equal(t, godrive.UnconfiguredDiskError{Name: "videos"}, err)
+}
@@ -0,0 +1,293 @@
+package godrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultVersionPrefix is the default prefix under which WithVersions stores the versions of files.
+	DefaultVersionPrefix = ".versions/"
+
+	deleteMarkerSuffix = ".deleted"
+)
+
+var (
+	// ErrVersionNotFound is returned when a version of a file does not exist.
+	ErrVersionNotFound = errors.New("version not found")
+	// ErrDeleteMarker is returned by GetVersion and RestoreVersion when the version is a delete
+	// marker, which has no content.
+	ErrDeleteMarker = errors.New("version is a delete marker")
+)
+
+// VersionOption is an option for the versioning decorator.
+type VersionOption func(*versionedDisk)
+
+// VersionPrefix sets the prefix under which the versions of files are stored.
+// Defaults to DefaultVersionPrefix.
+func VersionPrefix(prefix string) VersionOption {
+	return func(d *versionedDisk) {
+		d.prefix = prefix
+	}
+}
+
+// WithVersions wraps disk so that it implements Versioner for disks without native versioning.
+// Every write stores a copy of the file as a new version under a hidden prefix
+// ("<prefix><path>/<version id>") and Delete stores a delete marker, so deleted files can be restored.
+// The hidden prefix is excluded from List. ListVersions requires disk to support listing (CapListing).
+//
+// Versions are never deleted automatically. Use DeleteVersion to remove old versions.
+func WithVersions(disk Disk, options ...VersionOption) Disk {
+	d := &versionedDisk{prefix: DefaultVersionPrefix}
+	for _, opt := range options {
+		opt(d)
+	}
+
+	d.next = disk
+
+	return newInterceptedDisk(disk, Interceptor{
+		Put:            d.put,
+		PutReader:      d.putReader,
+		Delete:         d.delete,
+		List:           d.list,
+		Copy:           d.copy,
+		ListVersions:   d.listVersions,
+		GetVersion:     d.getVersion,
+		RestoreVersion: d.restoreVersion,
+		DeleteVersion:  d.deleteVersion,
+	}, Capabilities(disk)|CapVersioning)
+}
+
+type versionedDisk struct {
+	next   Disk
+	prefix string
+
+	mux    sync.Mutex
+	lastID int64
+}
+
+// newID returns a new version id. Version ids are the zero-padded nanoseconds of the
+// creation time, so they sort chronologically.
+func (d *versionedDisk) newID() string {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	id := time.Now().UnixNano()
+	if id <= d.lastID {
+		id = d.lastID + 1
+	}
+	d.lastID = id
+
+	return fmt.Sprintf("%020d", id)
+}
+
+func (d *versionedDisk) versionPath(path, id string) string {
+	return d.prefix + path + "/" + id
+}
+
+func (d *versionedDisk) put(ctx context.Context, path string, b []byte, next PutFunc) error {
+	if err := next(ctx, path, b); err != nil {
+		return err
+	}
+	return d.next.Put(ctx, d.versionPath(path, d.newID()), b)
+}
+
+func (d *versionedDisk) putReader(ctx context.Context, path string, r io.Reader, next PutReaderFunc) error {
+	if err := next(ctx, path, r); err != nil {
+		return err
+	}
+	return d.storeVersion(ctx, path)
+}
+
+func (d *versionedDisk) copy(ctx context.Context, src, dst string, next CopyFunc) error {
+	if err := next(ctx, src, dst); err != nil {
+		return err
+	}
+	return d.storeVersion(ctx, dst)
+}
+
+// storeVersion stores the current file at path as a new version.
+func (d *versionedDisk) storeVersion(ctx context.Context, path string) error {
+	vpath := d.versionPath(path, d.newID())
+
+	if cdisk, ok := d.next.(Copier); ok && Capabilities(d.next).Has(CapCopy) {
+		return cdisk.Copy(ctx, path, vpath)
+	}
+
+	b, err := d.next.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	return d.next.Put(ctx, vpath, b)
+}
+
+func (d *versionedDisk) delete(ctx context.Context, path string, next DeleteFunc) error {
+	if err := next(ctx, path); err != nil {
+		return err
+	}
+	return d.next.Put(ctx, d.versionPath(path, d.newID())+deleteMarkerSuffix, nil)
+}
+
+func (d *versionedDisk) list(ctx context.Context, prefix string, next ListFunc) ([]string, error) {
+	paths, err := next(ctx, prefix)
+	if err != nil {
+		return paths, err
+	}
+
+	filtered := paths[:0]
+	for _, p := range paths {
+		if !strings.HasPrefix(p, d.prefix) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered, nil
+}
+
+// listVersions returns the versions of the file at the given path, newest first.
+// The newest version is the current version. If the file is deleted, the newest version is a delete marker.
+func (d *versionedDisk) listVersions(ctx context.Context, path string, _ ListVersionsFunc) ([]Version, error) {
+	caps := Capabilities(d.next)
+
+	ldisk, ok := d.next.(Lister)
+	if !ok || !caps.Has(CapListing) {
+		return nil, UnimplementedError{Interface: new(Lister)}
+	}
+
+	dir := d.versionPath(path, "")
+	paths, err := ldisk.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sdisk, canStat := d.next.(Stater)
+	canStat = canStat && caps.Has(CapStat)
+
+	var versions []Version
+	for _, p := range paths {
+		name := strings.TrimPrefix(p, dir)
+		id := strings.TrimSuffix(name, deleteMarkerSuffix)
+
+		nanos, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || strings.Contains(name, "/") {
+			// The version of a file in a subdirectory of path.
+			continue
+		}
+
+		v := Version{
+			ID:           id,
+			ModTime:      time.Unix(0, nanos),
+			DeleteMarker: id != name,
+		}
+
+		if canStat && !v.DeleteMarker {
+			info, err := sdisk.Stat(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			v.Size = info.Size
+		}
+
+		versions = append(versions, v)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].ID > versions[j].ID
+	})
+
+	if len(versions) > 0 {
+		versions[0].Latest = true
+	}
+
+	return versions, nil
+}
+
+// getVersion retrieves a specific version of the file at the given path.
+// If the version does not exist, it returns ErrVersionNotFound. If the version is a delete marker,
+// it returns ErrDeleteMarker.
+func (d *versionedDisk) getVersion(ctx context.Context, path, versionID string, _ GetVersionFunc) ([]byte, error) {
+	if _, err := strconv.ParseInt(versionID, 10, 64); err != nil {
+		return nil, ErrVersionNotFound
+	}
+
+	b, err := d.next.Get(ctx, d.versionPath(path, versionID))
+	if err == nil {
+		return b, nil
+	}
+
+	// The error of the disk doesn't tell if the version doesn't exist, so look it up.
+	versions, i, lerr := d.findVersion(ctx, path, versionID)
+	switch {
+	case errors.Is(lerr, ErrVersionNotFound):
+		return nil, lerr
+	case lerr == nil && versions[i].DeleteMarker:
+		return nil, ErrDeleteMarker
+	default:
+		return nil, err
+	}
+}
+
+// restoreVersion writes a specific version of the file at the given path as a new version.
+func (d *versionedDisk) restoreVersion(ctx context.Context, path, versionID string, _ RestoreVersionFunc) error {
+	b, err := d.getVersion(ctx, path, versionID, nil)
+	if err != nil {
+		return err
+	}
+	return d.put(ctx, path, b, d.next.Put)
+}
+
+// deleteVersion permanently deletes a specific version or delete marker of the file at the given path.
+// If the current version is deleted, the previous version becomes the current version.
+// If the version does not exist, it returns ErrVersionNotFound.
+func (d *versionedDisk) deleteVersion(ctx context.Context, path, versionID string, _ DeleteVersionFunc) error {
+	versions, i, err := d.findVersion(ctx, path, versionID)
+	if err != nil {
+		return err
+	}
+
+	vpath := d.versionPath(path, versionID)
+	if versions[i].DeleteMarker {
+		vpath += deleteMarkerSuffix
+	}
+	if err := d.next.Delete(ctx, vpath); err != nil {
+		return err
+	}
+
+	if !versions[i].Latest {
+		return nil
+	}
+
+	if i+1 == len(versions) || versions[i+1].DeleteMarker {
+		return d.next.Delete(ctx, path)
+	}
+
+	b, err := d.next.Get(ctx, d.versionPath(path, versions[i+1].ID))
+	if err != nil {
+		return err
+	}
+	return d.next.Put(ctx, path, b)
+}
+
+// findVersion returns the versions of the file at the given path and the index of the version
+// with the given id. If the version does not exist, it returns ErrVersionNotFound.
+func (d *versionedDisk) findVersion(ctx context.Context, path, versionID string) ([]Version, int, error) {
+	versions, err := d.listVersions(ctx, path, nil)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	for i, v := range versions {
+		if v.ID == versionID {
+			return versions, i, nil
+		}
+	}
+
+	return nil, -1, ErrVersionNotFound
+}
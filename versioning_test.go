@@ -0,0 +1,173 @@
+package godrive_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/internal/memdisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithVersions(t *testing.T) {
+	base := memdisk.NewLister()
+	disk := godrive.WithVersions(base)
+	vdisk := disk.(godrive.Versioner)
+	ctx := context.Background()
+
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapVersioning|godrive.CapListing|godrive.CapStat))
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("v1")))
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("v2!")))
+	assert.Nil(t, disk.Put(ctx, "file.txt/nested.txt", []byte("nested")))
+
+	versions, err := vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].Latest)
+	assert.False(t, versions[1].Latest)
+	assert.Equal(t, int64(3), versions[0].Size)
+	assert.False(t, versions[0].ModTime.Before(versions[1].ModTime))
+
+	b, err := vdisk.GetVersion(ctx, "file.txt", versions[1].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), b)
+
+	_, err = vdisk.GetVersion(ctx, "file.txt", "../secret")
+	assert.Equal(t, godrive.ErrVersionNotFound, err)
+	_, err = vdisk.GetVersion(ctx, "file.txt", "1")
+	assert.Equal(t, godrive.ErrVersionNotFound, err)
+	assert.Equal(t, godrive.ErrVersionNotFound, vdisk.RestoreVersion(ctx, "file.txt", "1"))
+
+	// The versions are hidden.
+	paths, err := disk.(godrive.Lister).List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"file.txt", "file.txt/nested.txt"}, paths)
+
+	assert.Nil(t, vdisk.RestoreVersion(ctx, "file.txt", versions[1].ID))
+	b, err = disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), b)
+
+	versions, err = vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 3)
+}
+
+func TestWithVersions_delete(t *testing.T) {
+	disk := godrive.WithVersions(memdisk.NewLister(), godrive.VersionPrefix("_history/"))
+	vdisk := disk.(godrive.Versioner)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("content")))
+	assert.Nil(t, disk.Delete(ctx, "file.txt"))
+
+	_, err := disk.Get(ctx, "file.txt")
+	assert.NotNil(t, err)
+
+	versions, err := vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].DeleteMarker)
+	assert.True(t, versions[0].Latest)
+
+	_, err = vdisk.GetVersion(ctx, "file.txt", versions[0].ID)
+	assert.Equal(t, godrive.ErrDeleteMarker, err)
+	assert.Equal(t, godrive.ErrDeleteMarker, vdisk.RestoreVersion(ctx, "file.txt", versions[0].ID))
+
+	// Deleting the delete marker restores the file.
+	assert.Nil(t, vdisk.DeleteVersion(ctx, "file.txt", versions[0].ID))
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), b)
+
+	// Deleting the only version deletes the file.
+	assert.Nil(t, vdisk.DeleteVersion(ctx, "file.txt", versions[1].ID))
+	_, err = disk.Get(ctx, "file.txt")
+	assert.NotNil(t, err)
+
+	versions, err = vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Empty(t, versions)
+
+	assert.Equal(t, godrive.ErrVersionNotFound, vdisk.DeleteVersion(ctx, "file.txt", "1"))
+}
+
+func TestWithVersions_deleteCurrentVersion(t *testing.T) {
+	disk := godrive.WithVersions(memdisk.NewLister())
+	vdisk := disk.(godrive.Versioner)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("v1")))
+	assert.Nil(t, disk.Put(ctx, "file.txt", []byte("v2")))
+
+	versions, _ := vdisk.ListVersions(ctx, "file.txt")
+	assert.Nil(t, vdisk.DeleteVersion(ctx, "file.txt", versions[0].ID))
+
+	b, err := disk.Get(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), b)
+}
+
+func TestWithVersions_unimplemented(t *testing.T) {
+	disk := godrive.WithVersions(memdisk.New())
+
+	_, err := disk.(godrive.Versioner).ListVersions(context.Background(), "file.txt")
+	assert.Equal(t, godrive.UnimplementedError{Interface: new(godrive.Lister)}, err)
+}
+
+// limitedDisk implements Copier and Stater but reports that it only supports listing and streaming.
+type limitedDisk struct {
+	memdisk.Lister
+	copied bool
+}
+
+func (d *limitedDisk) PutReader(ctx context.Context, path string, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return d.Put(ctx, path, b)
+}
+
+func (d *limitedDisk) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	b, err := d.Get(ctx, path)
+	return io.NopCloser(bytes.NewReader(b)), err
+}
+
+func (d *limitedDisk) Copy(ctx context.Context, src, dst string) error {
+	d.copied = true
+	b, err := d.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+	return d.Put(ctx, dst, b)
+}
+
+func (d *limitedDisk) Capabilities() godrive.Capability {
+	return godrive.CapListing | godrive.CapStreaming
+}
+
+func TestWithVersions_capabilities(t *testing.T) {
+	base := &limitedDisk{Lister: memdisk.NewLister()}
+	disk := godrive.WithVersions(base)
+	ctx := context.Background()
+
+	assert.Equal(t, godrive.CapListing|godrive.CapStreaming|godrive.CapVersioning, godrive.Capabilities(disk))
+
+	assert.Nil(t, disk.(godrive.Streamer).PutReader(ctx, "file.txt", strings.NewReader("v1")))
+	assert.False(t, base.copied)
+
+	versions, err := disk.(godrive.Versioner).ListVersions(ctx, "file.txt")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 1)
+	// The size is unknown without Stater.
+	assert.Equal(t, int64(0), versions[0].Size)
+
+	b, err := disk.(godrive.Versioner).GetVersion(ctx, "file.txt", versions[0].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), b)
+}
@@ -0,0 +1,93 @@
+package webdav
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bounoable/godrive"
+)
+
+const (
+	// Provider is the provider name for WebDAV.
+	Provider = "webdav"
+)
+
+// Schema is the autowire configuration schema for WebDAV disks.
+var Schema = godrive.ConfigSchema{
+	Description: "WebDAV",
+	Fields: []godrive.ConfigField{
+		{
+			Key:         "url",
+			Type:        godrive.TypeString,
+			Required:    true,
+			Description: "URL of the collection that contains the files, e.g. https://cloud.example.com/remote.php/dav/files/user/.",
+		},
+		{
+			Key:         "username",
+			Type:        godrive.TypeString,
+			Description: "Username for basic authentication.",
+		},
+		{
+			Key:         "password",
+			Type:        godrive.TypeString,
+			Description: "Password for basic authentication.",
+		},
+		{
+			Key:         "token",
+			Type:        godrive.TypeString,
+			Description: "Token for bearer authentication.",
+		},
+	},
+}
+
+// Register registers WebDAV as a provider for the disk autowire.
+func Register(cfg *godrive.AutoWireConfig) {
+	cfg.RegisterProvider(Provider, godrive.DiskCreatorFunc(NewAutoWire))
+	cfg.RegisterSchema(Provider, Schema)
+}
+
+// NewAutoWire creates a new WebDAV disk from an autowire configuration.
+func NewAutoWire(_ context.Context, cfg map[string]interface{}) (godrive.Disk, error) {
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	url, ok := cfg["url"].(string)
+	if !ok || url == "" {
+		return nil, InvalidConfigValueError{
+			Key:     "url",
+			Details: "url must be set",
+		}
+	}
+
+	var options []Option
+
+	if token, ok := cfg["token"].(string); ok && token != "" {
+		options = append(options, BearerAuth(token))
+	}
+
+	if username, ok := cfg["username"].(string); ok && username != "" {
+		password, _ := cfg["password"].(string)
+		options = append(options, BasicAuth(username, password))
+	}
+
+	disk, err := NewDisk(nil, url, options...)
+	if err != nil {
+		return nil, InvalidConfigValueError{
+			Key:     "url",
+			Details: err.Error(),
+		}
+	}
+
+	return disk, nil
+}
+
+// InvalidConfigValueError means the autowire configuration has an invalid config value.
+type InvalidConfigValueError struct {
+	Key     string
+	Details string
+}
+
+func (err InvalidConfigValueError) Error() string {
+	return fmt.Sprintf("invalid configuration value for key '%s': %s", err.Key, err.Details)
+}
@@ -0,0 +1,423 @@
+// Package webdav provides the WebDAV disk implementation (e.g. for Nextcloud and ownCloud).
+package webdav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bounoable/godrive"
+)
+
+// Disk is the WebDAV disk.
+type Disk struct {
+	Client *http.Client
+	Config Config
+
+	base *url.URL
+}
+
+// Config is the disk configuration.
+type Config struct {
+	// URL is the URL of the collection that contains the files of the disk.
+	URL      string
+	Username string
+	Password string
+	// Token is used for bearer authentication instead of Username and Password.
+	Token string
+}
+
+// Option is a disk configuration option.
+type Option func(*Config)
+
+// BasicAuth configures the disk to use basic authentication.
+func BasicAuth(username, password string) Option {
+	return func(cfg *Config) {
+		cfg.Username = username
+		cfg.Password = password
+	}
+}
+
+// BearerAuth configures the disk to use bearer authentication.
+func BearerAuth(token string) Option {
+	return func(cfg *Config) {
+		cfg.Token = token
+	}
+}
+
+// NewDisk creates a new WebDAV disk for the collection at rawURL.
+// If client is nil, http.DefaultClient is used.
+func NewDisk(client *http.Client, rawURL string, options ...Option) (*Disk, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/") + "/"
+
+	cfg := Config{URL: base.String()}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	return &Disk{
+		Client: client,
+		Config: cfg,
+		base:   base,
+	}, nil
+}
+
+// Put writes b to the file at the given path.
+// Missing parent collections are created.
+func (d *Disk) Put(ctx context.Context, p string, b []byte) error {
+	err := d.put(ctx, p, bytes.NewReader(b))
+	// RFC 4918 requires 409 if a parent collection is missing, but some servers respond with 404.
+	if !isStatus(err, http.StatusConflict) && !isStatus(err, http.StatusNotFound) {
+		return err
+	}
+
+	if err := d.mkcolAll(ctx, path.Dir(cleanPath(p))); err != nil {
+		return err
+	}
+
+	return d.put(ctx, p, bytes.NewReader(b))
+}
+
+// PutReader writes r to the file at the given path.
+// Missing parent collections are created.
+func (d *Disk) PutReader(ctx context.Context, p string, r io.Reader) error {
+	if err := d.mkcolAll(ctx, path.Dir(cleanPath(p))); err != nil {
+		return err
+	}
+	return d.put(ctx, p, r)
+}
+
+func (d *Disk) put(ctx context.Context, p string, r io.Reader) error {
+	resp, err := d.do(ctx, http.MethodPut, p, r, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// mkcolAll creates the collection at the given path and all missing parents.
+// The collection of the disk itself must exist.
+func (d *Disk) mkcolAll(ctx context.Context, dir string) error {
+	if dir == "/" || dir == "." {
+		return nil
+	}
+
+	err := d.mkcol(ctx, dir)
+	if !isStatus(err, http.StatusConflict) {
+		return err
+	}
+
+	if err := d.mkcolAll(ctx, path.Dir(dir)); err != nil {
+		return err
+	}
+
+	return d.mkcol(ctx, dir)
+}
+
+func (d *Disk) mkcol(ctx context.Context, dir string) error {
+	resp, err := d.do(ctx, "MKCOL", dir+"/", nil, nil)
+	if isStatus(err, http.StatusMethodNotAllowed) {
+		// The collection already exists.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get retrieves the file at the given path.
+func (d *Disk) Get(ctx context.Context, p string) ([]byte, error) {
+	r, err := d.GetReader(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// GetReader returns a reader for the file at the given path.
+func (d *Disk) GetReader(ctx context.Context, p string) (io.ReadCloser, error) {
+	resp, err := d.do(ctx, http.MethodGet, p, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Delete deletes the file at the given path.
+func (d *Disk) Delete(ctx context.Context, p string) error {
+	resp, err := d.do(ctx, http.MethodDelete, p, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// GetURL returns the URL of the resource at the given path.
+func (d *Disk) GetURL(_ context.Context, p string) (string, error) {
+	return d.url(p), nil
+}
+
+// Copy copies the file at src to dst using the WebDAV COPY method.
+// Missing parent collections of dst are created.
+func (d *Disk) Copy(ctx context.Context, src, dst string) error {
+	if err := d.mkcolAll(ctx, path.Dir(cleanPath(dst))); err != nil {
+		return err
+	}
+
+	resp, err := d.do(ctx, "COPY", src, nil, http.Header{
+		"Destination": {d.url(dst)},
+		"Overwrite":   {"T"},
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// List returns the paths of all files whose path begins with prefix.
+// Collections are listed one level at a time, because many servers don't support "Depth: infinity".
+func (d *Disk) List(ctx context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	dir := path.Dir("/" + prefix)
+	if strings.HasSuffix(prefix, "/") {
+		dir = cleanPath(prefix)
+	}
+
+	var paths []string
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		resources, err := d.propfind(ctx, strings.TrimSuffix(dir, "/")+"/", "1")
+		if isStatus(err, http.StatusNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, res := range resources {
+			// The response contains the collection itself.
+			if res.path == strings.Trim(dir, "/") {
+				continue
+			}
+
+			if res.collection {
+				if strings.HasPrefix(res.path+"/", prefix) || strings.HasPrefix(prefix, res.path+"/") {
+					if err := walk("/" + res.path); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if strings.HasPrefix(res.path, prefix) {
+				paths = append(paths, res.path)
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(dir); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// Stat returns information about the file at the given path.
+func (d *Disk) Stat(ctx context.Context, p string) (godrive.FileInfo, error) {
+	resources, err := d.propfind(ctx, p, "0")
+	if err != nil {
+		return godrive.FileInfo{}, err
+	}
+	if len(resources) == 0 {
+		return godrive.FileInfo{}, fmt.Errorf("propfind '%s': empty response", p)
+	}
+
+	res := resources[0]
+	return godrive.FileInfo{
+		Path:        strings.TrimPrefix(p, "/"),
+		Size:        res.size,
+		ContentType: res.contentType,
+		ModTime:     res.modTime,
+	}, nil
+}
+
+// CheckHealth checks if the collection of the disk is reachable with the credentials of the disk.
+func (d *Disk) CheckHealth(ctx context.Context) error {
+	_, err := d.propfind(ctx, "/", "0")
+	return err
+}
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:">
+  <d:prop>
+    <d:resourcetype/>
+    <d:getcontentlength/>
+    <d:getcontenttype/>
+    <d:getlastmodified/>
+  </d:prop>
+</d:propfind>`
+
+type resource struct {
+	path        string
+	collection  bool
+	size        int64
+	contentType string
+	modTime     time.Time
+}
+
+type multistatus struct {
+	Responses []struct {
+		Href     string `xml:"href"`
+		Propstat []struct {
+			Status string `xml:"status"`
+			Prop   struct {
+				ResourceType struct {
+					Collection *struct{} `xml:"collection"`
+				} `xml:"resourcetype"`
+				ContentLength string `xml:"getcontentlength"`
+				ContentType   string `xml:"getcontenttype"`
+				LastModified  string `xml:"getlastmodified"`
+			} `xml:"prop"`
+		} `xml:"propstat"`
+	} `xml:"response"`
+}
+
+func (d *Disk) propfind(ctx context.Context, p, depth string) ([]resource, error) {
+	resp, err := d.do(ctx, "PROPFIND", p, strings.NewReader(propfindBody), http.Header{
+		"Depth":        {depth},
+		"Content-Type": {"application/xml; charset=utf-8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("decode propfind response: %w", err)
+	}
+
+	resources := make([]resource, 0, len(ms.Responses))
+	for _, r := range ms.Responses {
+		href, err := url.Parse(r.Href)
+		if err != nil {
+			return nil, fmt.Errorf("invalid href '%s': %w", r.Href, err)
+		}
+
+		res := resource{
+			path: strings.TrimSuffix(strings.TrimPrefix(href.Path, d.base.Path), "/"),
+		}
+
+		for _, ps := range r.Propstat {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+			prop := ps.Prop
+			res.collection = res.collection || prop.ResourceType.Collection != nil
+			if prop.ContentLength != "" {
+				res.size, _ = strconv.ParseInt(prop.ContentLength, 10, 64)
+			}
+			if prop.ContentType != "" {
+				res.contentType = prop.ContentType
+			}
+			if prop.LastModified != "" {
+				res.modTime, _ = http.ParseTime(prop.LastModified)
+			}
+		}
+
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+// do sends a request for the resource at the given path.
+// It returns a StatusError if the server does not respond with a 2xx status code.
+func (d *Disk) do(ctx context.Context, method, p string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, d.url(p), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, vals := range header {
+		req.Header[key] = vals
+	}
+
+	switch {
+	case d.Config.Token != "":
+		req.Header.Set("Authorization", "Bearer "+d.Config.Token)
+	case d.Config.Username != "":
+		req.SetBasicAuth(d.Config.Username, d.Config.Password)
+	}
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, StatusError{
+			Method:     method,
+			URL:        req.URL.String(),
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	return resp, nil
+}
+
+func (d *Disk) url(p string) string {
+	u := *d.base
+	u.Path = d.base.Path + strings.TrimPrefix(cleanPath(p), "/")
+	if strings.HasSuffix(p, "/") && !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u.String()
+}
+
+func cleanPath(p string) string {
+	return path.Join("/", p)
+}
+
+// StatusError is returned when the server responds with a non-2xx status code.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+}
+
+func (err StatusError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", err.Method, err.URL, err.StatusCode, http.StatusText(err.StatusCode))
+}
+
+func isStatus(err error, code int) bool {
+	serr, ok := err.(StatusError)
+	return ok && serr.StatusCode == code
+}
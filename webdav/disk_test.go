@@ -0,0 +1,149 @@
+package webdav_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bounoable/godrive"
+	"github.com/bounoable/godrive/webdav"
+	"github.com/stretchr/testify/assert"
+	xwebdav "golang.org/x/net/webdav"
+)
+
+func newTestServer(t *testing.T, auth func(*http.Request) bool) *httptest.Server {
+	handler := &xwebdav.Handler{
+		Prefix:     "/dav",
+		FileSystem: xwebdav.NewMemFS(),
+		LockSystem: xwebdav.NewMemLS(),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth != nil && !auth(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestDisk(t *testing.T, config map[string]interface{}) (*webdav.Disk, *httptest.Server) {
+	srv := newTestServer(t, func(r *http.Request) bool {
+		user, password, ok := r.BasicAuth()
+		return ok && user == "bob" && password == "secret"
+	})
+
+	cfg := map[string]interface{}{
+		"url":      srv.URL + "/dav/files/bob",
+		"username": "bob",
+		"password": "secret",
+	}
+	for key, val := range config {
+		cfg[key] = val
+	}
+
+	disk, err := webdav.NewAutoWire(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The collection of the disk must exist.
+	for _, dir := range []string{"/dav/files/", "/dav/files/bob/"} {
+		req, _ := http.NewRequest("MKCOL", srv.URL+dir, nil)
+		req.SetBasicAuth("bob", "secret")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	return disk.(*webdav.Disk), srv
+}
+
+func TestDisk(t *testing.T) {
+	disk, srv := newTestDisk(t, nil)
+	ctx := context.Background()
+
+	assert.Nil(t, disk.Put(ctx, "dir/sub/file1.txt", []byte("content1")))
+	assert.Nil(t, disk.PutReader(ctx, "dir/other/file 2.txt", strings.NewReader("content2")))
+	assert.Nil(t, disk.Put(ctx, "other.txt", []byte("other")))
+
+	b, err := disk.Get(ctx, "dir/sub/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content1"), b)
+
+	r, err := disk.GetReader(ctx, "dir/other/file 2.txt")
+	assert.Nil(t, err)
+	b, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, []byte("content2"), b)
+
+	url, err := disk.GetURL(ctx, "dir/other/file 2.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/dav/files/bob/dir/other/file%202.txt", url)
+
+	paths, err := disk.List(ctx, "dir/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"dir/sub/file1.txt", "dir/other/file 2.txt"}, paths)
+
+	paths, err = disk.List(ctx, "dir/su")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/sub/file1.txt"}, paths)
+
+	paths, err = disk.List(ctx, "oth")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"other.txt"}, paths)
+
+	paths, err = disk.List(ctx, "missing/")
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+
+	info, err := disk.Stat(ctx, "dir/sub/file1.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "dir/sub/file1.txt", info.Path)
+	assert.Equal(t, int64(len("content1")), info.Size)
+	assert.False(t, info.ModTime.IsZero())
+
+	assert.Nil(t, disk.Copy(ctx, "other.txt", "copies/other.txt"))
+	b, err = disk.Get(ctx, "copies/other.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("other"), b)
+
+	assert.Nil(t, disk.Delete(ctx, "other.txt"))
+	_, err = disk.Get(ctx, "other.txt")
+	assert.Equal(t, http.StatusNotFound, err.(webdav.StatusError).StatusCode)
+
+	assert.Nil(t, disk.CheckHealth(ctx))
+}
+
+func TestDisk_unauthorized(t *testing.T) {
+	disk, _ := newTestDisk(t, nil)
+	disk.Config.Password = "wrong"
+
+	err := disk.Put(context.Background(), "file.txt", []byte("content"))
+	assert.Equal(t, http.StatusUnauthorized, err.(webdav.StatusError).StatusCode)
+	assert.NotNil(t, disk.CheckHealth(context.Background()))
+}
+
+func TestDisk_bearerAuth(t *testing.T) {
+	srv := newTestServer(t, func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer token"
+	})
+
+	disk, err := webdav.NewAutoWire(context.Background(), map[string]interface{}{
+		"url":   srv.URL + "/dav/",
+		"token": "token",
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, disk.Put(context.Background(), "file.txt", []byte("content")))
+	assert.True(t, godrive.Capabilities(disk).Has(godrive.CapURL|godrive.CapStreaming|godrive.CapListing|
+		godrive.CapStat|godrive.CapCopy|godrive.CapHealth))
+}